@@ -41,3 +41,11 @@ output "api_service_alerts" {
   ]
   description = "Recent alerts for API services"
 }
+
+# Let the API do the filtering: last week's alerts for one check
+data "uptime_alerts" "last_week" {
+  check_id    = 123
+  start       = "-7d"
+  state_is_up = false
+  max_items   = 50
+}
@@ -0,0 +1,10 @@
+# Look up a contact by name
+data "uptime_contact" "sre" {
+  name = "SRE"
+}
+
+resource "uptime_check_http" "api" {
+  name           = "API"
+  address        = "https://api.example.com"
+  contact_groups = [data.uptime_contact.sre.name]
+}
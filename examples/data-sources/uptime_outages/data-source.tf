@@ -53,3 +53,11 @@ output "ongoing_incidents" {
   ]
   description = "Currently ongoing outages"
 }
+
+# Let the API do the filtering: outages of production checks in March 2024
+data "uptime_outages" "production" {
+  tag     = "production"
+  start   = "2024-03-01T00:00:00Z"
+  end     = "2024-04-01T00:00:00Z"
+  ignored = false
+}
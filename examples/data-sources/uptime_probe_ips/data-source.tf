@@ -0,0 +1,24 @@
+# Probe addresses for the locations used by a check
+data "uptime_probe_ips" "api" {
+  check_ids = [uptime_check_http.api.id]
+}
+
+# Allow those probes through an AWS security group
+resource "aws_vpc_security_group_ingress_rule" "uptime" {
+  for_each = toset(data.uptime_probe_ips.api.ipv4_cidrs)
+
+  security_group_id = aws_security_group.api.id
+  cidr_ipv4         = each.value
+  ip_protocol       = "tcp"
+  from_port         = 443
+  to_port           = 443
+}
+
+# Probe addresses for selected locations
+data "uptime_probe_ips" "eu" {
+  locations = ["United Kingdom-London", "Netherlands-Amsterdam"]
+}
+
+output "eu_allowlist" {
+  value = concat(data.uptime_probe_ips.eu.ipv4_cidrs, data.uptime_probe_ips.eu.ipv6_cidrs)
+}
@@ -0,0 +1,9 @@
+# Look up a status page by slug
+data "uptime_statuspage" "public" {
+  slug = "example"
+}
+
+resource "uptime_statuspage_component" "api" {
+  statuspage_id = data.uptime_statuspage.public.id
+  name          = "API"
+}
@@ -0,0 +1,8 @@
+# List group checks tagged "production"
+list "uptime_check_group" "production" {
+  provider = uptime
+
+  config {
+    tags = ["production"]
+  }
+}
@@ -0,0 +1,4 @@
+# List all contacts
+list "uptime_contact" "all" {
+  provider = uptime
+}
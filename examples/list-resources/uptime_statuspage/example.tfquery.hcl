@@ -0,0 +1,4 @@
+# List all status pages
+list "uptime_statuspage" "all" {
+  provider = uptime
+}
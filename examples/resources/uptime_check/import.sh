@@ -0,0 +1,2 @@
+# Import using the check type and ID
+terraform import uptime_check.example HTTP:123
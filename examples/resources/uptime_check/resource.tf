@@ -0,0 +1,38 @@
+# HTTP check through the generic resource
+resource "uptime_check" "example" {
+  type = "HTTP"
+  http = {
+    name           = "Example Website"
+    address        = "https://example.com"
+    contact_groups = ["nobody"]
+    interval       = 5
+    expect_string  = "Example Domain"
+  }
+}
+
+# Heartbeat check; read-only attributes are in the nested attribute too
+resource "uptime_check" "backup" {
+  type = "HEARTBEAT"
+  heartbeat = {
+    name     = "Daily Backup Monitor"
+    interval = 1440
+  }
+}
+
+output "heartbeat_url" {
+  value = uptime_check.backup.heartbeat.heartbeat_url
+}
+
+# Move an existing uptime_check_http resource without recreating the check
+moved {
+  from = uptime_check_http.legacy
+  to   = uptime_check.legacy
+}
+
+resource "uptime_check" "legacy" {
+  type = "HTTP"
+  http = {
+    name    = "Legacy Website"
+    address = "https://legacy.example.com"
+  }
+}
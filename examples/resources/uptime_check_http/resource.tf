@@ -43,6 +43,39 @@ resource "uptime_check_http" "with_sla" {
   }
 }
 
+# HTTP check that is paused instead of deleted on destroy, keeping its
+# alert history, outages and SLA data
+resource "uptime_check_http" "retired" {
+  name       = "Legacy Service"
+  address    = "https://legacy.example.com"
+  on_destroy = "pause"
+}
+
+# HTTP check with escalations and a weekly maintenance window managed inline,
+# instead of separate uptime_check_escalations and uptime_check_maintenance resources
+resource "uptime_check_http" "with_escalations" {
+  name    = "Checkout Service"
+  address = "https://shop.example.com/checkout"
+  escalations = [
+    {
+      wait_time      = 300
+      num_repeats    = 3
+      contact_groups = ["Default"]
+    },
+  ]
+  maintenance = {
+    state = "SCHEDULED"
+    schedule = [
+      {
+        type      = "WEEKLY"
+        weekdays  = [6]
+        from_time = "02:00:00"
+        to_time   = "04:00:00"
+      },
+    ]
+  }
+}
+
 # HTTP check with advanced options
 resource "uptime_check_http" "advanced" {
   name                      = "Full Featured Check"
@@ -0,0 +1,26 @@
+resource "uptime_statuspage" "example" {
+  name = "My Service Status"
+}
+
+# One component for every check tagged "public", named after the check
+resource "uptime_statuspage_component_set" "public" {
+  statuspage_id    = uptime_statuspage.example.id
+  tags             = ["public"]
+  name_template    = "{name}"
+  auto_status_down = "major-outage"
+  auto_status_up   = "operational"
+}
+
+resource "uptime_check_group" "backend" {
+  name = "Backend"
+  config = {
+    tags = ["backend"]
+  }
+}
+
+# One component for every check in a check group
+resource "uptime_statuspage_component_set" "backend" {
+  statuspage_id  = uptime_statuspage.example.id
+  check_group_id = uptime_check_group.backend.id
+  name_template  = "Backend: {name}"
+}
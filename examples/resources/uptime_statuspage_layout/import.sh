@@ -0,0 +1,2 @@
+# Import using the status page ID
+terraform import uptime_statuspage_layout.example 123
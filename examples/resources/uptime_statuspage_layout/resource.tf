@@ -0,0 +1,40 @@
+resource "uptime_statuspage" "example" {
+  name = "My Service Status"
+}
+
+resource "uptime_statuspage_component" "website" {
+  statuspage_id = uptime_statuspage.example.id
+  name          = "Website"
+}
+
+resource "uptime_statuspage_component" "backend" {
+  statuspage_id = uptime_statuspage.example.id
+  name          = "Backend"
+  is_group      = true
+}
+
+resource "uptime_statuspage_component" "api" {
+  statuspage_id = uptime_statuspage.example.id
+  name          = "API"
+}
+
+resource "uptime_statuspage_component" "database" {
+  statuspage_id = uptime_statuspage.example.id
+  name          = "Database"
+}
+
+# Website first, then the Backend group holding API and Database
+resource "uptime_statuspage_layout" "example" {
+  statuspage_id = uptime_statuspage.example.id
+
+  items = [
+    { component_id = uptime_statuspage_component.website.id },
+    {
+      component_id = uptime_statuspage_component.backend.id
+      component_ids = [
+        uptime_statuspage_component.api.id,
+        uptime_statuspage_component.database.id,
+      ]
+    },
+  ]
+}
@@ -0,0 +1,21 @@
+resource "uptime_statuspage" "customers" {
+  name             = "Customer Status"
+  visibility_level = "EXTERNAL_USERS"
+}
+
+# Grant access to every contact in a CSV export with email, first_name and
+# last_name columns
+locals {
+  customer_contacts = csvdecode(file("${path.module}/customers.csv"))
+}
+
+resource "uptime_statuspage_users" "customers" {
+  statuspage_id = uptime_statuspage.customers.id
+
+  users = {
+    for c in local.customer_contacts : lower(c.email) => {
+      first_name = c.first_name
+      last_name  = c.last_name
+    }
+  }
+}
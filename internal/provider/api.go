@@ -5,10 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
 )
 
@@ -58,6 +64,14 @@ type API[A, R any] interface {
 	Delete(context.Context, upapi.PrimaryKeyable) error
 }
 
+// Finder is an optional interface for APIs whose objects have a unique natural
+// key, such as a contact name. Find returns the primary key of the existing
+// object matching arg, or nil if there is none. Resources whose API implements
+// it must declare the adopt_existing attribute.
+type Finder[A any] interface {
+	Find(context.Context, A) (upapi.PrimaryKeyable, error)
+}
+
 type APIModel interface {
 	upapi.PrimaryKeyable
 }
@@ -93,6 +107,11 @@ type APIResourceMetadata struct {
 	schema.Schema
 	TypeNameSuffix   string
 	ConfigValidators func(context.Context) []resource.ConfigValidator
+	// OnDestroy enables the on_destroy attribute; nil means Delete always deletes.
+	OnDestroy *OnDestroyHandler
+	// Inline lists attributes written through their own endpoints after the
+	// object itself is created or updated.
+	Inline []InlineAttribute
 }
 
 type APIResource[M APIModel, A, R any] struct {
@@ -137,6 +156,9 @@ func (r APIResource[M, A, R]) apiConversionError(op string, src, dst any, err er
 }
 
 func (r APIResource[M, A, R]) Create(ctx context.Context, rq resource.CreateRequest, rs *resource.CreateResponse) {
+	ctx, op := startOperation(ctx, r.meta.TypeNameSuffix, "create")
+	defer func() { op.End(rs.Diagnostics) }()
+
 	planModel, diags := r.mod.Get(ctx, rq.Plan)
 	rs.Diagnostics.Append(diags...)
 	if rs.Diagnostics.HasError() {
@@ -149,9 +171,9 @@ func (r APIResource[M, A, R]) Create(ctx context.Context, rq resource.CreateRequ
 		return
 	}
 
-	res, err := r.api.Create(ctx, *arg)
-	if err != nil {
-		rs.Diagnostics.Append(r.apiOperationError(apiOperationCreate, err))
+	res, diags := r.create(ctx, rq.Plan, *arg)
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
@@ -160,6 +182,8 @@ func (r APIResource[M, A, R]) Create(ctx context.Context, rq resource.CreateRequ
 		rs.Diagnostics.Append(r.apiConversionError(fromAPIResultError, res, resultModel, err))
 		return
 	}
+	op.SetID(int64((*resultModel).PrimaryKey()))
+	rs.Diagnostics.Append(setIdentity(ctx, rs.Identity, *resultModel)...)
 
 	// If the modeler implements PlanValuePreserver, use it to preserve plan values
 	// for fields that the API doesn't return (like sensitive fields)
@@ -172,15 +196,74 @@ func (r APIResource[M, A, R]) Create(ctx context.Context, rq resource.CreateRequ
 	if rs.Diagnostics.HasError() {
 		return
 	}
+	rs.Diagnostics.Append(r.preserveLocalAttributes(ctx, rq.Plan, &rs.State)...)
+	for _, a := range r.meta.Inline {
+		rs.Diagnostics.Append(a.Apply(ctx, *resultModel, rq.Plan, &rs.State)...)
+	}
 	return
 }
 
+// create creates the remote object. If the API implements Finder and the plan
+// sets adopt_existing, an existing object with the same natural key is updated
+// to match arg and taken over instead.
+func (r APIResource[M, A, R]) create(ctx context.Context, plan tfsdk.Plan, arg A) (*R, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if finder, ok := any(r.api).(Finder[A]); ok {
+		adopt, d := AdoptExistingValue(ctx, plan)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if adopt {
+			pk, err := finder.Find(ctx, arg)
+			if err != nil {
+				diags.Append(r.apiOperationError(apiOperationRead, err))
+				return nil, diags
+			}
+			if pk != nil {
+				res, err := r.api.Update(ctx, pk, arg)
+				if err != nil {
+					diags.Append(r.apiOperationError(apiOperationUpdate, err))
+					return nil, diags
+				}
+				diags.Append(adoptedWarning(r.meta.TypeNameSuffix, pk))
+				return res, diags
+			}
+		}
+	}
+	res, err := r.api.Create(ctx, arg)
+	if err != nil {
+		diags.Append(r.apiOperationError(apiOperationCreate, err))
+		return nil, diags
+	}
+	return res, diags
+}
+
+// preserveLocalAttributes copies attributes that exist only on the Terraform
+// side (on_destroy, adopt_existing) from src into dst, since the API result
+// never carries them.
+func (r APIResource[M, A, R]) preserveLocalAttributes(ctx context.Context, src attributeGetter, dst *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.meta.OnDestroy != nil {
+		diags.Append(r.meta.OnDestroy.Preserve(ctx, src, dst)...)
+	}
+	if _, ok := any(r.api).(Finder[A]); ok {
+		diags.Append(PreserveAdoptExisting(ctx, src, dst)...)
+	}
+	return diags
+}
+
 func (r APIResource[M, A, R]) Read(ctx context.Context, rq resource.ReadRequest, rs *resource.ReadResponse) {
+	ctx, op := startOperation(ctx, r.meta.TypeNameSuffix, "read")
+	defer func() { op.End(rs.Diagnostics) }()
+
 	stateModel, diags := r.mod.Get(ctx, rq.State)
 	rs.Diagnostics.Append(diags...)
 	if rs.Diagnostics.HasError() {
 		return
 	}
+	op.SetID(int64((*stateModel).PrimaryKey()))
+	rs.Diagnostics.Append(setIdentity(ctx, rs.Identity, *stateModel)...)
 
 	res, err := r.api.Read(ctx, *stateModel)
 	if err != nil {
@@ -212,15 +295,24 @@ func (r APIResource[M, A, R]) Read(ctx context.Context, rq resource.ReadRequest,
 	if rs.Diagnostics.HasError() {
 		return
 	}
+	rs.Diagnostics.Append(r.preserveLocalAttributes(ctx, rq.State, &rs.State)...)
+	for _, a := range r.meta.Inline {
+		rs.Diagnostics.Append(a.Refresh(ctx, *resultModel, rq.State, &rs.State)...)
+	}
 	return
 }
 
 func (r APIResource[M, A, R]) Update(ctx context.Context, rq resource.UpdateRequest, rs *resource.UpdateResponse) {
+	ctx, op := startOperation(ctx, r.meta.TypeNameSuffix, "update")
+	defer func() { op.End(rs.Diagnostics) }()
+
 	state, diags := r.mod.Get(ctx, rq.State)
 	rs.Diagnostics.Append(diags...)
 	if rs.Diagnostics.HasError() {
 		return
 	}
+	op.SetID(int64((*state).PrimaryKey()))
+	rs.Diagnostics.Append(setIdentity(ctx, rs.Identity, *state)...)
 
 	planModel, diags := r.mod.Get(ctx, rq.Plan)
 	rs.Diagnostics.Append(diags...)
@@ -256,15 +348,42 @@ func (r APIResource[M, A, R]) Update(ctx context.Context, rq resource.UpdateRequ
 	if rs.Diagnostics.HasError() {
 		return
 	}
+	rs.Diagnostics.Append(r.preserveLocalAttributes(ctx, rq.Plan, &rs.State)...)
+	for _, a := range r.meta.Inline {
+		rs.Diagnostics.Append(a.Apply(ctx, *resultModel, rq.Plan, &rs.State)...)
+	}
 	return
 }
 
 func (r APIResource[M, A, R]) Delete(ctx context.Context, rq resource.DeleteRequest, rs *resource.DeleteResponse) {
+	ctx, op := startOperation(ctx, r.meta.TypeNameSuffix, "delete")
+	defer func() { op.End(rs.Diagnostics) }()
+
 	state, diags := r.mod.Get(ctx, rq.State)
 	rs.Diagnostics.Append(diags...)
 	if rs.Diagnostics.HasError() {
 		return
 	}
+	op.SetID(int64((*state).PrimaryKey()))
+
+	if r.meta.OnDestroy != nil {
+		mode, diags := r.meta.OnDestroy.Mode(ctx, rq.State)
+		rs.Diagnostics.Append(diags...)
+		if rs.Diagnostics.HasError() {
+			return
+		}
+		switch mode {
+		case OnDestroyAbandon:
+			rs.Diagnostics.Append(r.meta.OnDestroy.Warning(r.meta.TypeNameSuffix, mode, int64((*state).PrimaryKey())))
+			return
+		case OnDestroyPause:
+			r.pauseOnDestroy(ctx, rq.State, rs)
+			if !rs.Diagnostics.HasError() {
+				rs.Diagnostics.Append(r.meta.OnDestroy.Warning(r.meta.TypeNameSuffix, mode, int64((*state).PrimaryKey())))
+			}
+			return
+		}
+	}
 
 	if err := r.api.Delete(ctx, *state); err != nil {
 		rs.Diagnostics.Append(r.apiOperationError(apiOperationDelete, err))
@@ -274,6 +393,38 @@ func (r APIResource[M, A, R]) Delete(ctx context.Context, rq resource.DeleteRequ
 	return
 }
 
+// pauseOnDestroy updates the remote object from the prior state with is_paused
+// set and the marker tag added, instead of deleting it.
+func (r APIResource[M, A, R]) pauseOnDestroy(ctx context.Context, state tfsdk.State, rs *resource.DeleteResponse) {
+	paused, diags := r.meta.OnDestroy.Paused(ctx, state)
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	model, diags := r.mod.Get(ctx, paused)
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	arg, err := r.mod.ToAPIArgument(*model)
+	if err != nil {
+		rs.Diagnostics.Append(r.apiConversionError(toAPIArgumentError, model, arg, err))
+		return
+	}
+
+	if err := r.meta.OnDestroy.EnsureTag(ctx, r.meta.OnDestroy.PauseTag); err != nil {
+		rs.Diagnostics.Append(r.apiOperationError(apiOperationUpdate, err))
+		return
+	}
+
+	if _, err := r.api.Update(ctx, *model, *arg); err != nil {
+		rs.Diagnostics.Append(r.apiOperationError(apiOperationUpdate, err))
+		return
+	}
+}
+
 // ImportableAPIResource wraps APIResource and adds import support.
 // Use this for resources that need import functionality.
 type ImportableAPIResource[M APIModel, A, R any] struct {
@@ -302,3 +453,74 @@ func NewImportableAPIResource[M APIModel, A, R any](
 func (r ImportableAPIResource[M, A, R]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	r.importHandler(ctx, req, resp)
 }
+
+// setIdentity records the ID of the object in the resource identity. Resources
+// without an identity schema get a nil identity and are left alone.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, pk upapi.PrimaryKeyable) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.SetAttribute(ctx, path.Root("id"), int64(pk.PrimaryKey()))
+}
+
+// IdentityAPIResource wraps ImportableAPIResource and adds a resource identity
+// made of the object ID. Resources need one to be listed by `terraform query`
+// and to be imported with an `identity` block.
+type IdentityAPIResource[M APIModel, A, R any] struct {
+	ImportableAPIResource[M, A, R]
+}
+
+// NewIdentityAPIResource creates a new IdentityAPIResource with the given import handler.
+func NewIdentityAPIResource[M APIModel, A, R any](
+	api API[A, R],
+	mod APIModeler[M, A, R],
+	meta APIResourceMetadata,
+	importHandler func(context.Context, resource.ImportStateRequest, *resource.ImportStateResponse),
+) IdentityAPIResource[M, A, R] {
+	return IdentityAPIResource[M, A, R]{
+		ImportableAPIResource: NewImportableAPIResource(api, mod, meta, importHandler),
+	}
+}
+
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r IdentityAPIResource[M, A, R]) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, rs *resource.IdentitySchemaResponse) {
+	rs.IdentitySchema = identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Unique identifier for the resource",
+			},
+		},
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState. Imports by identity
+// pass the ID from the identity to the import handler as if it had been given
+// on the command line, so both kinds of import end up with the same state.
+func (r IdentityAPIResource[M, A, R]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" {
+		var id types.Int64
+		resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root("id"), &id)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		req.ID = strconv.FormatInt(id.ValueInt64(), 10)
+	}
+	r.importHandler(ctx, req, resp)
+}
+
+// listResult converts an object returned by the API to a `terraform query`
+// result. The resource object is only filled in when requested.
+func (r IdentityAPIResource[M, A, R]) listResult(ctx context.Context, rq list.ListRequest, item R) list.ListResult {
+	result := rq.NewListResult(ctx)
+	model, err := r.mod.FromAPIResult(item)
+	if err != nil {
+		result.Diagnostics.Append(r.apiConversionError(fromAPIResultError, item, model, err))
+		return result
+	}
+	result.Diagnostics.Append(setIdentity(ctx, result.Identity, *model)...)
+	if rq.IncludeResource {
+		result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+	}
+	return result
+}
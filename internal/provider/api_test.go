@@ -1,11 +1,16 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
 )
 
@@ -54,3 +59,125 @@ func TestIsNotFoundError(t *testing.T) {
 		})
 	}
 }
+
+// stubContactAPI records which API calls APIResource makes on create.
+type stubContactAPI struct {
+	existing upapi.PrimaryKeyable
+	calls    *[]string
+}
+
+func (s stubContactAPI) Create(_ context.Context, arg upapi.Contact) (*upapi.Contact, error) {
+	*s.calls = append(*s.calls, "create")
+	arg.PK = 1
+	return &arg, nil
+}
+
+func (s stubContactAPI) Read(context.Context, upapi.PrimaryKeyable) (*upapi.Contact, error) {
+	return nil, errors.New("unexpected read")
+}
+
+func (s stubContactAPI) Update(_ context.Context, pk upapi.PrimaryKeyable, arg upapi.Contact) (*upapi.Contact, error) {
+	*s.calls = append(*s.calls, fmt.Sprintf("update %d", pk.PrimaryKey()))
+	arg.PK = int64(pk.PrimaryKey())
+	return &arg, nil
+}
+
+func (s stubContactAPI) Delete(context.Context, upapi.PrimaryKeyable) error {
+	return errors.New("unexpected delete")
+}
+
+func (s stubContactAPI) Find(context.Context, upapi.Contact) (upapi.PrimaryKeyable, error) {
+	*s.calls = append(*s.calls, "find")
+	return s.existing, nil
+}
+
+func TestAPIResourceCreateAdoptExisting(t *testing.T) {
+	tests := []struct {
+		name     string
+		adopt    bool
+		existing upapi.PrimaryKeyable
+		want     []string
+		wantID   int64
+		warnings int
+	}{
+		{
+			name:   "adoption disabled creates",
+			adopt:  false,
+			want:   []string{"create"},
+			wantID: 1,
+		},
+		{
+			name:   "nothing to adopt creates",
+			adopt:  true,
+			want:   []string{"find", "create"},
+			wantID: 1,
+		},
+		{
+			name:     "existing object is updated instead",
+			adopt:    true,
+			existing: upapi.PrimaryKey(42),
+			want:     []string{"find", "update 42"},
+			wantID:   42,
+			warnings: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			var calls []string
+			r := NewContactResource(ctx, nil).(IdentityAPIResource[ContactResourceModel, upapi.Contact, upapi.Contact])
+			r.api = stubContactAPI{existing: tt.existing, calls: &calls}
+
+			plan := tfsdk.Plan{
+				Schema: r.meta.Schema,
+				Raw:    tftypes.NewValue(r.meta.Schema.Type().TerraformType(ctx), nil),
+			}
+			plan.SetAttribute(ctx, path.Root("name"), "on-call")
+			plan.SetAttribute(ctx, path.Root("adopt_existing"), tt.adopt)
+
+			res, diags := r.create(ctx, plan, upapi.Contact{Name: "on-call"})
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if res.PK != tt.wantID {
+				t.Errorf("PK = %d, want %d", res.PK, tt.wantID)
+			}
+			if diags.WarningsCount() != tt.warnings {
+				t.Errorf("warnings = %d, want %d", diags.WarningsCount(), tt.warnings)
+			}
+			if fmt.Sprint(calls) != fmt.Sprint(tt.want) {
+				t.Errorf("calls = %v, want %v", calls, tt.want)
+			}
+		})
+	}
+}
+
+func TestIdentityAPIResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	var got []string
+	r := NewContactResource(ctx, nil).(IdentityAPIResource[ContactResourceModel, upapi.Contact, upapi.Contact])
+	r.importHandler = func(ctx context.Context, rq resource.ImportStateRequest, rs *resource.ImportStateResponse) {
+		got = append(got, rq.ID)
+	}
+
+	var identitySchema resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identitySchema)
+	identityType := identitySchema.IdentitySchema.Type().TerraformType(ctx)
+
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "7"}, &resource.ImportStateResponse{})
+	var rs resource.ImportStateResponse
+	r.ImportState(ctx, resource.ImportStateRequest{
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identitySchema.IdentitySchema,
+			Raw: tftypes.NewValue(identityType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.Number, 42),
+			}),
+		},
+	}, &rs)
+	if rs.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", rs.Diagnostics)
+	}
+	if fmt.Sprint(got) != "[7 42]" {
+		t.Errorf("import handler got IDs %v, want [7 42]", got)
+	}
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func AdoptExistingSchemaAttribute(naturalKey string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		Description: fmt.Sprintf("When true, creating this resource adopts an existing object with the same `%s` "+
+			"instead of failing: the object is updated to match the configuration and managed by this resource "+
+			"from then on, including deletion on destroy. Only consulted on create.", naturalKey),
+	}
+}
+
+// AdoptExistingValue reads adopt_existing from src, treating null and unknown as false.
+func AdoptExistingValue(ctx context.Context, src attributeGetter) (bool, diag.Diagnostics) {
+	var v types.Bool
+	diags := src.GetAttribute(ctx, path.Root("adopt_existing"), &v)
+	return v.ValueBool(), diags
+}
+
+// PreserveAdoptExisting copies adopt_existing from src into dst. Imported state
+// carries no value, so it is recorded as false.
+func PreserveAdoptExisting(ctx context.Context, src attributeGetter, dst *tfsdk.State) diag.Diagnostics {
+	adopt, diags := AdoptExistingValue(ctx, src)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(dst.SetAttribute(ctx, path.Root("adopt_existing"), adopt)...)
+	return diags
+}
+
+func adoptedWarning(typeNameSuffix string, pk upapi.PrimaryKeyable) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		"Existing Resource Adopted",
+		fmt.Sprintf(
+			"uptime_%s with ID %d already existed and was adopted because adopt_existing = true. "+
+				"It was updated to match the configuration and is now managed by Terraform.",
+			typeNameSuffix, pk.PrimaryKey(),
+		),
+	)
+}
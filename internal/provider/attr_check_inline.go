@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+// InlineAttribute manages a check attribute that the API stores behind its own
+// endpoint, such as escalations or maintenance. A null value means the
+// resource does not manage it, leaving it to the standalone resource.
+type InlineAttribute interface {
+	// Apply writes the planned value for the object pk and records the API
+	// result in dst.
+	Apply(ctx context.Context, pk upapi.PrimaryKeyable, plan attributeGetter, dst *tfsdk.State) diag.Diagnostics
+	// Refresh records the current value for the object pk in dst, if the
+	// prior state manages it.
+	Refresh(ctx context.Context, pk upapi.PrimaryKeyable, prior attributeGetter, dst *tfsdk.State) diag.Diagnostics
+}
+
+// CheckInlineAttributes returns the inline attributes shared by all check
+// resources. The schema must declare escalations and maintenance.
+func CheckInlineAttributes(p *providerImpl) []InlineAttribute {
+	return []InlineAttribute{
+		checkEscalationsInline{provider: p},
+		checkMaintenanceInline{provider: p},
+	}
+}
+
+func EscalationsSchemaAttribute(p *providerImpl) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional: true,
+		Description: `Escalation rules for this check, as in uptime_check_escalations. ` +
+			`When omitted, escalations are not managed by this resource and are left as they are; ` +
+			`set an empty list to remove them. Don't combine with uptime_check_escalations for the same check.`,
+		NestedObject: EscalationsNestedObject(p),
+		PlanModifiers: []planmodifier.List{
+			CheckAttributeOwnerPlanModifier(p, "escalations", true),
+		},
+	}
+}
+
+func MaintenanceSchemaAttribute(p *providerImpl) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Description: `Maintenance settings for this check, as in uptime_check_maintenance. ` +
+			`When omitted, maintenance is not managed by this resource and is left as it is. ` +
+			`Don't combine with uptime_check_maintenance for the same check.`,
+		Attributes: CheckMaintenanceAttributes(),
+		PlanModifiers: []planmodifier.Object{
+			CheckAttributeOwnerPlanModifier(p, "maintenance", true),
+		},
+	}
+}
+
+// CheckInlineAttributesAdapter provides the values check models start with
+// before the inline attributes are applied or refreshed.
+type CheckInlineAttributesAdapter struct {
+	escalationsAttributeContextAdapter
+	maintenance CheckMaintenanceResourceModelAdapter
+}
+
+func (a CheckInlineAttributesAdapter) EscalationsNullValue() types.List {
+	return a.escalationsAttributeValue(nil)
+}
+
+func (a CheckInlineAttributesAdapter) MaintenanceNullValue() types.Object {
+	return types.ObjectNull(a.maintenanceAttributeTypes())
+}
+
+func (a CheckInlineAttributesAdapter) maintenanceAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"state":                          types.StringType,
+		"pause_on_scheduled_maintenance": types.BoolType,
+		"schedule": types.ListType{
+			ElemType: types.ObjectType{AttrTypes: a.maintenance.scheduleAttributeTypes()},
+		},
+	}
+}
+
+type checkMaintenanceAttribute struct {
+	State                       types.String `tfsdk:"state"`
+	PauseOnScheduledMaintenance types.Bool   `tfsdk:"pause_on_scheduled_maintenance"`
+	Schedule                    types.List   `tfsdk:"schedule"`
+}
+
+type checkEscalationsInline struct {
+	CheckInlineAttributesAdapter
+
+	provider *providerImpl
+}
+
+func (i checkEscalationsInline) Apply(ctx context.Context, pk upapi.PrimaryKeyable, plan attributeGetter, dst *tfsdk.State) diag.Diagnostics {
+	var v types.List
+	diags := plan.GetAttribute(ctx, path.Root("escalations"), &v)
+	if diags.HasError() || v.IsNull() {
+		return diags
+	}
+	escalations, d := i.escalationsAttributeContext(ctx, v)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	arg := upapi.CheckEscalations{Escalations: []upapi.CheckEscalation{}}
+	if escalations != nil {
+		arg.Escalations = i.escalationsToAPI(escalations)
+	}
+	res, err := i.provider.api.Checks().UpdateEscalations(ctx, pk, arg)
+	if err != nil {
+		diags.AddError("API Update Escalations Operation Failed", err.Error())
+		return diags
+	}
+	diags.Append(dst.SetAttribute(ctx, path.Root("escalations"), i.escalationsValue(res.Escalations))...)
+	return diags
+}
+
+func (i checkEscalationsInline) Refresh(ctx context.Context, pk upapi.PrimaryKeyable, prior attributeGetter, dst *tfsdk.State) diag.Diagnostics {
+	var v types.List
+	diags := prior.GetAttribute(ctx, path.Root("escalations"), &v)
+	if diags.HasError() || v.IsNull() {
+		return diags
+	}
+	res, err := i.provider.api.Checks().GetEscalations(ctx, pk)
+	if err != nil {
+		diags.AddError("API Get Escalations Operation Failed", err.Error())
+		return diags
+	}
+	diags.Append(dst.SetAttribute(ctx, path.Root("escalations"), i.escalationsValue(res.Escalations))...)
+	return diags
+}
+
+// escalationsValue converts the API result, keeping "no escalations" as an
+// empty list so it matches a configured empty list.
+func (i checkEscalationsInline) escalationsValue(api []upapi.CheckEscalation) types.List {
+	escalations := i.escalationsFromAPI(api)
+	if escalations == nil {
+		return i.escalationsAttributeValue(escalationsAttribute{})
+	}
+	return i.escalationsAttributeValue(*escalations)
+}
+
+type checkMaintenanceInline struct {
+	CheckInlineAttributesAdapter
+
+	provider *providerImpl
+}
+
+func (i checkMaintenanceInline) Apply(ctx context.Context, pk upapi.PrimaryKeyable, plan attributeGetter, dst *tfsdk.State) diag.Diagnostics {
+	var v types.Object
+	diags := plan.GetAttribute(ctx, path.Root("maintenance"), &v)
+	if diags.HasError() || v.IsNull() {
+		return diags
+	}
+	var m checkMaintenanceAttribute
+	diags.Append(v.As(ctx, &m, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+	model := CheckMaintenanceResourceModel{
+		CheckID:                     types.Int64Value(int64(pk.PrimaryKey())),
+		State:                       m.State,
+		PauseOnScheduledMaintenance: m.PauseOnScheduledMaintenance,
+		Schedule:                    m.Schedule,
+	}
+	var d diag.Diagnostics
+	model.schedule, d = i.maintenance.ScheduleContext(ctx, model.Schedule)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	arg, err := i.maintenance.ToAPIArgument(model)
+	if err != nil {
+		diags.AddError(toAPIArgumentError, err.Error())
+		return diags
+	}
+	res, err := CheckMaintenanceResourceAPI{provider: i.provider}.Update(ctx, pk, *arg)
+	if err != nil {
+		diags.AddError("API Update Maintenance Operation Failed", err.Error())
+		return diags
+	}
+	diags.Append(i.set(ctx, *res, dst)...)
+	return diags
+}
+
+func (i checkMaintenanceInline) Refresh(ctx context.Context, pk upapi.PrimaryKeyable, prior attributeGetter, dst *tfsdk.State) diag.Diagnostics {
+	var v types.Object
+	diags := prior.GetAttribute(ctx, path.Root("maintenance"), &v)
+	if diags.HasError() || v.IsNull() {
+		return diags
+	}
+	res, err := CheckMaintenanceResourceAPI{provider: i.provider}.Read(ctx, pk)
+	if err != nil {
+		diags.AddError("API Get Maintenance Operation Failed", err.Error())
+		return diags
+	}
+	diags.Append(i.set(ctx, *res, dst)...)
+	return diags
+}
+
+func (i checkMaintenanceInline) set(ctx context.Context, api CheckMaintenanceWrapper, dst *tfsdk.State) diag.Diagnostics {
+	var diags diag.Diagnostics
+	model, err := i.maintenance.FromAPIResult(api)
+	if err != nil {
+		diags.AddError(fromAPIResultError, err.Error())
+		return diags
+	}
+	v, d := types.ObjectValueFrom(ctx, i.maintenanceAttributeTypes(), checkMaintenanceAttribute{
+		State:                       model.State,
+		PauseOnScheduledMaintenance: model.PauseOnScheduledMaintenance,
+		Schedule:                    model.Schedule,
+	})
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(dst.SetAttribute(ctx, path.Root("maintenance"), v)...)
+	return diags
+}
+
+// checkAttributeOwners records which checks have an attribute managed inline
+// and which by a standalone resource, so that managing both can be reported.
+type checkAttributeOwners struct {
+	mu     sync.Mutex
+	owners map[checkAttributeKey]uint8
+}
+
+type checkAttributeKey struct {
+	name    string
+	checkID int64
+}
+
+const (
+	checkAttributeOwnerInline uint8 = 1 << iota
+	checkAttributeOwnerStandalone
+)
+
+// claim records an owner of the named attribute of a check and reports
+// whether the other kind of owner was recorded as well.
+func (o *checkAttributeOwners) claim(name string, checkID int64, inline bool) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.owners == nil {
+		o.owners = make(map[checkAttributeKey]uint8)
+	}
+	owner := checkAttributeOwnerStandalone
+	if inline {
+		owner = checkAttributeOwnerInline
+	}
+	key := checkAttributeKey{name: name, checkID: checkID}
+	o.owners[key] |= owner
+	return o.owners[key] == checkAttributeOwnerInline|checkAttributeOwnerStandalone
+}
+
+// CheckAttributeOwnerPlanModifier warns when a check attribute is managed both
+// inline on the check and by its standalone uptime_check_<name> resource, which
+// would overwrite each other on every apply. Set inline for the check's own
+// attribute and unset it for the standalone resource's check_id. Checks that
+// don't exist yet have no ID and can't be matched.
+func CheckAttributeOwnerPlanModifier(p *providerImpl, name string, inline bool) *checkAttributeOwnerPlanModifier {
+	return &checkAttributeOwnerPlanModifier{provider: p, name: name, inline: inline}
+}
+
+type checkAttributeOwnerPlanModifier struct {
+	provider *providerImpl
+	name     string
+	inline   bool
+}
+
+func (m *checkAttributeOwnerPlanModifier) Description(context.Context) string {
+	return fmt.Sprintf("Warn when %s are managed both inline and by uptime_check_%s", m.name, m.name)
+}
+
+func (m *checkAttributeOwnerPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m *checkAttributeOwnerPlanModifier) PlanModifyList(ctx context.Context, rq planmodifier.ListRequest, rs *planmodifier.ListResponse) {
+	if rq.ConfigValue.IsNull() {
+		return
+	}
+	rs.Diagnostics.Append(m.claimState(ctx, rq.Path, rq.State)...)
+}
+
+func (m *checkAttributeOwnerPlanModifier) PlanModifyObject(ctx context.Context, rq planmodifier.ObjectRequest, rs *planmodifier.ObjectResponse) {
+	if rq.ConfigValue.IsNull() {
+		return
+	}
+	rs.Diagnostics.Append(m.claimState(ctx, rq.Path, rq.State)...)
+}
+
+func (m *checkAttributeOwnerPlanModifier) PlanModifyInt64(_ context.Context, rq planmodifier.Int64Request, rs *planmodifier.Int64Response) {
+	if rq.PlanValue.IsNull() || rq.PlanValue.IsUnknown() {
+		return
+	}
+	rs.Diagnostics.Append(m.claim(rq.Path, rq.PlanValue.ValueInt64())...)
+}
+
+// claimState claims the attribute for the check ID recorded in state.
+func (m *checkAttributeOwnerPlanModifier) claimState(ctx context.Context, p path.Path, state tfsdk.State) diag.Diagnostics {
+	if state.Raw.IsNull() {
+		return nil
+	}
+	var id types.Int64
+	if diags := state.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() || id.IsNull() || id.IsUnknown() {
+		return nil
+	}
+	return m.claim(p, id.ValueInt64())
+}
+
+func (m *checkAttributeOwnerPlanModifier) claim(p path.Path, checkID int64) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if m.provider == nil || !m.provider.checkAttributeOwners.claim(m.name, checkID, m.inline) {
+		return diags
+	}
+	diags.AddAttributeWarning(p,
+		fmt.Sprintf("Check %s managed twice", m.name),
+		fmt.Sprintf("Check %d sets %s inline and is also the check_id of an uptime_check_%s resource. "+
+			"Both would overwrite each other on every apply; keep only one of them.", checkID, m.name, m.name),
+	)
+	return diags
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCheckAttributeOwnersClaim(t *testing.T) {
+	var o checkAttributeOwners
+	steps := []struct {
+		name    string
+		checkID int64
+		inline  bool
+		want    bool
+	}{
+		{"escalations", 1, true, false},
+		{"escalations", 1, true, false},
+		{"maintenance", 1, false, false},
+		{"escalations", 2, false, false},
+		{"escalations", 1, false, true},
+		{"maintenance", 1, true, true},
+	}
+	for i, s := range steps {
+		if got := o.claim(s.name, s.checkID, s.inline); got != s.want {
+			t.Errorf("step %d: claim(%q, %d, %v) = %v, want %v", i, s.name, s.checkID, s.inline, got, s.want)
+		}
+	}
+}
+
+func TestCheckAttributeOwnerPlanModifierStandalone(t *testing.T) {
+	ctx := context.Background()
+	p := &providerImpl{}
+	p.checkAttributeOwners.claim("escalations", 42, true)
+
+	run := func(v types.Int64) diag.Diagnostics {
+		rs := &planmodifier.Int64Response{PlanValue: v}
+		CheckAttributeOwnerPlanModifier(p, "escalations", false).PlanModifyInt64(ctx, planmodifier.Int64Request{
+			Path:        path.Root("check_id"),
+			ConfigValue: v,
+			PlanValue:   v,
+		}, rs)
+		return rs.Diagnostics
+	}
+
+	if diags := run(types.Int64Unknown()); len(diags) != 0 {
+		t.Errorf("unknown check_id: unexpected diagnostics: %v", diags)
+	}
+	if diags := run(types.Int64Value(7)); len(diags) != 0 {
+		t.Errorf("other check: unexpected diagnostics: %v", diags)
+	}
+	diags := run(types.Int64Value(42))
+	if len(diags) != 1 || diags[0].Severity() != diag.SeverityWarning {
+		t.Fatalf("same check: got %v, want one warning", diags)
+	}
+}
@@ -3,11 +3,12 @@ package provider
 import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func ContactGroupsSchemaAttribute() schema.SetAttribute {
+func ContactGroupsSchemaAttribute(p *providerImpl) schema.SetAttribute {
 	return schema.SetAttribute{
 		ElementType: types.StringType,
 		Description: `List of contact group names to receive notifications.
@@ -22,6 +23,9 @@ Set to an empty list to disable notifications at this level and rely on parent c
 		),
 		Optional: true,
 		Computed: true,
+		PlanModifiers: []planmodifier.Set{
+			ContactGroupsPlanModifier(p),
+		},
 	}
 }
 
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	OnDestroyDelete  = "delete"
+	OnDestroyPause   = "pause"
+	OnDestroyAbandon = "abandon"
+)
+
+// onDestroyPausedTag is assigned to checks that were paused instead of deleted,
+// so they can be found in the UI and re-adopted with `terraform import` later.
+const onDestroyPausedTag = "terraform-destroyed"
+
+// onDestroyPausedTagColor is used when the marker tag has to be created.
+const onDestroyPausedTagColor = "#808080"
+
+func OnDestroySchemaAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  stringdefault.StaticString(OnDestroyDelete),
+		Description: `What happens to the check when the resource is destroyed. ` +
+			`"delete" (default) removes the check together with its alert history, outages and SLA data. ` +
+			`"pause" keeps the check, pauses it and tags it with "` + onDestroyPausedTag + `". ` +
+			`"abandon" leaves the check untouched and only removes it from state.`,
+		Validators: []validator.String{
+			OneOfStringValidator([]string{OnDestroyDelete, OnDestroyPause, OnDestroyAbandon}),
+		},
+	}
+}
+
+// OnDestroyHandler enables the on_destroy attribute on an APIResource. The
+// schema must declare on_destroy, is_paused and tags; the model must carry the
+// matching on_destroy field. The API never returns on_destroy, so APIResource
+// copies it from the plan (or prior state on refresh) after every operation.
+type OnDestroyHandler struct {
+	// PauseTag is added to the object's tags when it is paused on destroy.
+	PauseTag string
+	// EnsureTag makes sure a tag exists in the account before it is assigned.
+	EnsureTag func(context.Context, string) error
+}
+
+// CheckOnDestroyHandler returns the on_destroy handler shared by all check resources.
+func CheckOnDestroyHandler(p *providerImpl) *OnDestroyHandler {
+	return &OnDestroyHandler{
+		PauseTag: onDestroyPausedTag,
+		EnsureTag: func(ctx context.Context, name string) error {
+			return p.EnsureTag(ctx, name, onDestroyPausedTagColor)
+		},
+	}
+}
+
+type attributeGetter interface {
+	GetAttribute(context.Context, path.Path, interface{}) diag.Diagnostics
+}
+
+// Mode returns the on_destroy value recorded in src, defaulting to "delete"
+// for state written before the attribute existed or imported state.
+func (h *OnDestroyHandler) Mode(ctx context.Context, src attributeGetter) (string, diag.Diagnostics) {
+	var v types.String
+	diags := src.GetAttribute(ctx, path.Root("on_destroy"), &v)
+	if diags.HasError() || v.IsNull() || v.IsUnknown() {
+		return OnDestroyDelete, diags
+	}
+	return v.ValueString(), diags
+}
+
+// Preserve copies on_destroy from src into dst.
+func (h *OnDestroyHandler) Preserve(ctx context.Context, src attributeGetter, dst *tfsdk.State) diag.Diagnostics {
+	mode, diags := h.Mode(ctx, src)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(dst.SetAttribute(ctx, path.Root("on_destroy"), mode)...)
+	return diags
+}
+
+// Paused returns a copy of state with is_paused set and PauseTag added to tags.
+func (h *OnDestroyHandler) Paused(ctx context.Context, state tfsdk.State) (tfsdk.State, diag.Diagnostics) {
+	var tags types.Set
+	diags := state.GetAttribute(ctx, path.Root("tags"), &tags)
+	if diags.HasError() {
+		return state, diags
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root("is_paused"), true)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("tags"), setWithString(tags, h.PauseTag))...)
+	return state, diags
+}
+
+// setWithString returns v with s added, keeping v unchanged if s is already present.
+func setWithString(v types.Set, s string) types.Set {
+	var elems []attr.Value
+	if !v.IsNull() && !v.IsUnknown() {
+		elems = v.Elements()
+	}
+	for _, e := range elems {
+		if e.Equal(types.StringValue(s)) {
+			return v
+		}
+	}
+	elems = append(elems, types.StringValue(s))
+	return types.SetValueMust(types.StringType, elems)
+}
+
+// Warning reports that the object was kept on the server instead of deleted.
+func (h *OnDestroyHandler) Warning(typeNameSuffix, mode string, id int64) diag.Diagnostic {
+	detail := fmt.Sprintf(
+		"uptime_%s with ID %d was removed from state but left in place on the server because on_destroy = %q.",
+		typeNameSuffix, id, mode,
+	)
+	if mode == OnDestroyPause {
+		detail += fmt.Sprintf(" It was paused and tagged %q; its history is kept.", h.PauseTag)
+	}
+	return diag.NewWarningDiagnostic("Resource Kept On Destroy", detail)
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testOnDestroyState(t *testing.T, mode types.String, tags types.Set) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"is_paused":  IsPausedSchemaAttribute(),
+			"tags":       TagsSchemaAttribute(),
+			"on_destroy": OnDestroySchemaAttribute(),
+		},
+	}
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := state.SetAttribute(ctx, path.Root("is_paused"), false); diags.HasError() {
+		t.Fatalf("set is_paused: %v", diags)
+	}
+	if diags := state.SetAttribute(ctx, path.Root("tags"), tags); diags.HasError() {
+		t.Fatalf("set tags: %v", diags)
+	}
+	if diags := state.SetAttribute(ctx, path.Root("on_destroy"), mode); diags.HasError() {
+		t.Fatalf("set on_destroy: %v", diags)
+	}
+	return state
+}
+
+func TestOnDestroyHandlerMode(t *testing.T) {
+	cases := map[string]struct {
+		value types.String
+		want  string
+	}{
+		"pause":   {types.StringValue(OnDestroyPause), OnDestroyPause},
+		"abandon": {types.StringValue(OnDestroyAbandon), OnDestroyAbandon},
+		"delete":  {types.StringValue(OnDestroyDelete), OnDestroyDelete},
+		// Imported state and state written by older provider versions carry no value.
+		"null defaults to delete": {types.StringNull(), OnDestroyDelete},
+	}
+	h := &OnDestroyHandler{PauseTag: onDestroyPausedTag}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			state := testOnDestroyState(t, tc.value, types.SetValueMust(types.StringType, []attr.Value{}))
+			got, diags := h.Mode(context.Background(), state)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tc.want {
+				t.Errorf("Mode() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestOnDestroyHandlerPaused(t *testing.T) {
+	ctx := context.Background()
+	h := &OnDestroyHandler{PauseTag: onDestroyPausedTag}
+	state := testOnDestroyState(t,
+		types.StringValue(OnDestroyPause),
+		types.SetValueMust(types.StringType, []attr.Value{types.StringValue("production")}),
+	)
+
+	paused, diags := h.Paused(ctx, state)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var isPaused types.Bool
+	paused.GetAttribute(ctx, path.Root("is_paused"), &isPaused)
+	if !isPaused.ValueBool() {
+		t.Errorf("is_paused = %v, want true", isPaused)
+	}
+
+	var tags types.Set
+	paused.GetAttribute(ctx, path.Root("tags"), &tags)
+	want := types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("production"),
+		types.StringValue(onDestroyPausedTag),
+	})
+	if !tags.Equal(want) {
+		t.Errorf("tags = %v, want %v", tags, want)
+	}
+
+	// The prior state must be left untouched.
+	state.GetAttribute(ctx, path.Root("is_paused"), &isPaused)
+	if isPaused.ValueBool() {
+		t.Errorf("prior state is_paused was modified")
+	}
+}
+
+func TestSetWithString(t *testing.T) {
+	cases := map[string]struct {
+		in   types.Set
+		want []string
+	}{
+		"null":    {types.SetNull(types.StringType), []string{"x"}},
+		"empty":   {types.SetValueMust(types.StringType, []attr.Value{}), []string{"x"}},
+		"append":  {types.SetValueMust(types.StringType, []attr.Value{types.StringValue("a")}), []string{"a", "x"}},
+		"present": {types.SetValueMust(types.StringType, []attr.Value{types.StringValue("x")}), []string{"x"}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := setWithString(tc.in, "x")
+			want := SetAttributeAdapter[string]{}.SliceValue(tc.want)
+			if !got.Equal(want) {
+				t.Errorf("setWithString() = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	ReferenceValidationError   = "error"
+	ReferenceValidationWarning = "warning"
+	ReferenceValidationOff     = "off"
+)
+
+// ReferencesGetter lists the names of account objects that resources refer to
+// by name rather than by ID, and tells how strictly to validate them.
+type ReferencesGetter interface {
+	GetContactGroups(context.Context) (map[string]struct{}, error)
+	ReferenceValidation() string
+}
+
+// ContactGroupsPlanModifier checks at plan time that the configured contact
+// group names exist, so a misspelled name is reported before apply instead of
+// failing halfway through it.
+func ContactGroupsPlanModifier(r ReferencesGetter) planmodifier.Set {
+	return &referencesPlanModifier{
+		getter: r,
+		kind:   "Contact group",
+		list: func(ctx context.Context) (map[string]struct{}, error) {
+			return r.GetContactGroups(ctx)
+		},
+	}
+}
+
+type referencesPlanModifier struct {
+	getter ReferencesGetter
+	kind   string
+	list   func(context.Context) (map[string]struct{}, error)
+}
+
+func (m *referencesPlanModifier) Description(context.Context) string {
+	return fmt.Sprintf("%s names must exist in the account", m.kind)
+}
+
+func (m *referencesPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m *referencesPlanModifier) PlanModifySet(ctx context.Context, rq planmodifier.SetRequest, rs *planmodifier.SetResponse) {
+	if m.getter.ReferenceValidation() == ReferenceValidationOff {
+		return
+	}
+	// Values from defaults or prior state were either validated before or are
+	// server-managed; an unknown set comes from resources that don't exist yet.
+	if rq.ConfigValue.IsNull() || rq.ConfigValue.IsUnknown() {
+		return
+	}
+	// Unchanged values can't fail the apply, don't spend API calls on them.
+	if rq.ConfigValue.Equal(rq.StateValue) {
+		return
+	}
+	if len(rq.ConfigValue.Elements()) == 0 {
+		return
+	}
+
+	names, err := m.list(ctx)
+	if err != nil {
+		rs.Diagnostics.AddAttributeWarning(rq.Path,
+			fmt.Sprintf("%s names were not validated", m.kind), err.Error())
+		return
+	}
+
+	for _, el := range rq.ConfigValue.Elements() {
+		sv, ok := el.(types.String)
+		if !ok || sv.IsNull() || sv.IsUnknown() {
+			continue
+		}
+		name := sv.ValueString()
+		if _, ok := names[name]; ok {
+			continue
+		}
+		detail := fmt.Sprintf("%s %q does not exist in the account.", m.kind, name)
+		if s := closestName(name, names); s != "" {
+			detail += fmt.Sprintf(" Did you mean %q?", s)
+		}
+		if m.getter.ReferenceValidation() == ReferenceValidationError {
+			rs.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(rq.Path,
+				fmt.Sprintf("%s not found", m.kind), detail))
+			continue
+		}
+		detail += " If it is created by this same apply, this warning can be ignored."
+		rs.Diagnostics.Append(diag.NewAttributeWarningDiagnostic(rq.Path,
+			fmt.Sprintf("%s not found", m.kind), detail))
+	}
+}
+
+// closestName returns the name in names most similar to s, or "" if none is
+// close enough to be a plausible typo.
+func closestName(s string, names map[string]struct{}) string {
+	best, bestDist := "", -1
+	for n := range names {
+		d := levenshtein(strings.ToLower(s), strings.ToLower(n))
+		if bestDist < 0 || d < bestDist || (d == bestDist && n < best) {
+			best, bestDist = n, d
+		}
+	}
+	limit := len([]rune(s)) / 3
+	if limit < 2 {
+		limit = 2
+	}
+	if bestDist < 0 || bestDist > limit {
+		return ""
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
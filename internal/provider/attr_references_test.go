@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type stubReferencesGetter struct {
+	mode          string
+	contactGroups map[string]struct{}
+	err           error
+	calls         int
+}
+
+func (s *stubReferencesGetter) GetContactGroups(context.Context) (map[string]struct{}, error) {
+	s.calls++
+	return s.contactGroups, s.err
+}
+
+func (s *stubReferencesGetter) ReferenceValidation() string {
+	return s.mode
+}
+
+func testStringSet(values ...string) types.Set {
+	elems := make([]attr.Value, len(values))
+	for i, v := range values {
+		elems[i] = types.StringValue(v)
+	}
+	return types.SetValueMust(types.StringType, elems)
+}
+
+func TestContactGroupsPlanModifier(t *testing.T) {
+	contactGroups := map[string]struct{}{"production": {}, "staging": {}}
+	cases := map[string]struct {
+		mode     string
+		config   types.Set
+		state    types.Set
+		err      error
+		severity diag.Severity
+		detail   string
+		calls    int
+	}{
+		"all known": {
+			mode:   ReferenceValidationWarning,
+			config: testStringSet("production"),
+			state:  types.SetNull(types.StringType),
+			calls:  1,
+		},
+		"typo warns with suggestion": {
+			mode:     ReferenceValidationWarning,
+			config:   testStringSet("prodution"),
+			state:    types.SetNull(types.StringType),
+			severity: diag.SeverityWarning,
+			detail:   `Did you mean "production"?`,
+			calls:    1,
+		},
+		"typo errors in strict mode": {
+			mode:     ReferenceValidationError,
+			config:   testStringSet("Staging"),
+			state:    types.SetNull(types.StringType),
+			severity: diag.SeverityError,
+			detail:   `Did you mean "staging"?`,
+			calls:    1,
+		},
+		"no suggestion for unrelated name": {
+			mode:     ReferenceValidationWarning,
+			config:   testStringSet("customer-facing"),
+			state:    types.SetNull(types.StringType),
+			severity: diag.SeverityWarning,
+			detail:   `Contact group "customer-facing" does not exist in the account.`,
+			calls:    1,
+		},
+		"unchanged value is not looked up": {
+			mode:   ReferenceValidationError,
+			config: testStringSet("gone"),
+			state:  testStringSet("gone"),
+		},
+		"unknown value is skipped": {
+			mode:   ReferenceValidationError,
+			config: types.SetUnknown(types.StringType),
+			state:  types.SetNull(types.StringType),
+		},
+		"unknown element is skipped": {
+			mode: ReferenceValidationError,
+			config: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringUnknown(), types.StringValue("staging"),
+			}),
+			state: types.SetNull(types.StringType),
+			calls: 1,
+		},
+		"off": {
+			mode:   ReferenceValidationOff,
+			config: testStringSet("prodution"),
+			state:  types.SetNull(types.StringType),
+		},
+		"lookup failure only warns": {
+			mode:     ReferenceValidationError,
+			config:   testStringSet("production"),
+			state:    types.SetNull(types.StringType),
+			err:      errors.New("boom"),
+			severity: diag.SeverityWarning,
+			detail:   "boom",
+			calls:    1,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			getter := &stubReferencesGetter{mode: tc.mode, contactGroups: contactGroups, err: tc.err}
+			rq := planmodifier.SetRequest{
+				Path:        path.Root("contact_groups"),
+				ConfigValue: tc.config,
+				StateValue:  tc.state,
+				PlanValue:   tc.config,
+			}
+			rs := &planmodifier.SetResponse{PlanValue: rq.PlanValue}
+			ContactGroupsPlanModifier(getter).PlanModifySet(context.Background(), rq, rs)
+
+			if getter.calls != tc.calls {
+				t.Errorf("GetContactGroups called %d times, want %d", getter.calls, tc.calls)
+			}
+			if tc.detail == "" {
+				if len(rs.Diagnostics) != 0 {
+					t.Fatalf("unexpected diagnostics: %v", rs.Diagnostics)
+				}
+				return
+			}
+			if len(rs.Diagnostics) != 1 {
+				t.Fatalf("got %d diagnostics, want 1: %v", len(rs.Diagnostics), rs.Diagnostics)
+			}
+			d := rs.Diagnostics[0]
+			if d.Severity() != tc.severity {
+				t.Errorf("severity = %v, want %v", d.Severity(), tc.severity)
+			}
+			if !strings.Contains(d.Detail(), tc.detail) {
+				t.Errorf("detail = %q, want it to contain %q", d.Detail(), tc.detail)
+			}
+			if !rs.PlanValue.Equal(tc.config) {
+				t.Errorf("plan value changed to %v", rs.PlanValue)
+			}
+		})
+	}
+}
+
+func TestReferenceValidationUnconfigured(t *testing.T) {
+	var p *providerImpl
+	if got := p.ReferenceValidation(); got != ReferenceValidationOff {
+		t.Errorf("nil provider: ReferenceValidation() = %q, want %q", got, ReferenceValidationOff)
+	}
+	p = &providerImpl{referenceValidation: ReferenceValidationError}
+	if got := p.ReferenceValidation(); got != ReferenceValidationOff {
+		t.Errorf("unconfigured provider: ReferenceValidation() = %q, want %q", got, ReferenceValidationOff)
+	}
+}
+
+func TestClosestName(t *testing.T) {
+	names := map[string]struct{}{"Default": {}, "Ops On-Call": {}, "dev-team": {}}
+	cases := []struct {
+		in, want string
+	}{
+		{"Defualt", "Default"},
+		{"default", "Default"},
+		{"Ops on call", "Ops On-Call"},
+		{"dev_team", "dev-team"},
+		{"marketing", ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.in, func(t *testing.T) {
+			if got := closestName(tc.in, names); got != tc.want {
+				t.Errorf("closestName(%q) = %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -16,7 +18,7 @@ func NewAlertsDataSource(_ context.Context, p *providerImpl) datasource.DataSour
 // AlertsDataSchema defines the schema for the alerts data source.
 var AlertsDataSchema = schema.Schema{
 	Description: "Retrieve a list of alerts from your Uptime.com account. Alerts are generated when a check detects an issue from a monitoring location.",
-	Attributes: map[string]schema.Attribute{
+	Attributes: EventFilterSchemaAttributes("alerts", map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
@@ -85,11 +87,12 @@ var AlertsDataSchema = schema.Schema{
 				},
 			},
 		},
-	},
+	}),
 }
 
 type AlertsDataSourceModel struct {
-	ID     types.String                `tfsdk:"id"`
+	ID types.String `tfsdk:"id"`
+	EventFilterModel
 	Alerts []AlertsDataSourceItemModel `tfsdk:"alerts"`
 }
 
@@ -124,45 +127,83 @@ func (d AlertsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	rs.Schema = AlertsDataSchema
 }
 
-func (d AlertsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, rs *datasource.ReadResponse) {
-	api, err := d.p.api.Alerts().List(ctx, upapi.AlertListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+func (d AlertsDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config AlertsDataSourceModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
-
-	model := AlertsDataSourceModel{
-		ID:     types.StringValue(""),
-		Alerts: make([]AlertsDataSourceItemModel, len(api.Items)),
+	filter, diags := config.EventFilterModel.Resolve(ctx, d.p.api, time.Now())
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
+		return
 	}
 
-	for i := range api.Items {
-		createdAt := ""
-		if api.Items[i].CreatedAt != nil {
-			createdAt = api.Items[i].CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	alerts := make([]AlertsDataSourceItemModel, 0)
+	var seen int64
+	for page := int64(1); ; page++ {
+		if page > listMaxPages {
+			rs.Diagnostics.AddError("API call failed",
+				fmt.Sprintf("Listing alerts paged past %d pages without reaching the end. "+
+					"The server may be returning inconsistent counts.", listMaxPages))
+			return
 		}
-
-		resolvedAt := ""
-		if api.Items[i].ResolvedAt != nil {
-			resolvedAt = api.Items[i].ResolvedAt.Format("2006-01-02T15:04:05Z07:00")
+		api, err := d.p.api.Alerts().List(ctx, upapi.AlertListOptions{
+			Page:     page,
+			PageSize: listPageSize,
+		})
+		if err != nil {
+			rs.Diagnostics.AddError("API call failed", err.Error())
+			return
 		}
-
-		model.Alerts[i] = AlertsDataSourceItemModel{
-			ID:                         types.Int64Value(api.Items[i].PK),
-			URL:                        types.StringValue(api.Items[i].URL),
-			CreatedAt:                  types.StringValue(createdAt),
-			ResolvedAt:                 types.StringValue(resolvedAt),
-			MonitoringServerName:       types.StringValue(api.Items[i].MonitoringServerName),
-			Location:                   types.StringValue(api.Items[i].Location),
-			Output:                     types.StringValue(api.Items[i].Output),
-			StateIsUp:                  types.BoolValue(api.Items[i].StateIsUp),
-			Ignored:                    types.BoolValue(api.Items[i].Ignored),
-			CheckPK:                    types.Int64Value(api.Items[i].CheckPK),
-			CheckURL:                   types.StringValue(api.Items[i].CheckURL),
-			CheckAddress:               types.StringValue(api.Items[i].CheckAddress),
-			CheckName:                  types.StringValue(api.Items[i].CheckName),
-			CheckMonitoringServiceType: types.StringValue(api.Items[i].CheckMonitoringServiceType),
+		for _, item := range api.Items {
+			var created time.Time
+			if item.CreatedAt != nil {
+				created = *item.CreatedAt
+			}
+			if !filter.Match(item.CheckPK, item.StateIsUp, item.Ignored, created) {
+				continue
+			}
+
+			createdAt := ""
+			if item.CreatedAt != nil {
+				createdAt = item.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+			}
+
+			resolvedAt := ""
+			if item.ResolvedAt != nil {
+				resolvedAt = item.ResolvedAt.Format("2006-01-02T15:04:05Z07:00")
+			}
+
+			alerts = append(alerts, AlertsDataSourceItemModel{
+				ID:                         types.Int64Value(item.PK),
+				URL:                        types.StringValue(item.URL),
+				CreatedAt:                  types.StringValue(createdAt),
+				ResolvedAt:                 types.StringValue(resolvedAt),
+				MonitoringServerName:       types.StringValue(item.MonitoringServerName),
+				Location:                   types.StringValue(item.Location),
+				Output:                     types.StringValue(item.Output),
+				StateIsUp:                  types.BoolValue(item.StateIsUp),
+				Ignored:                    types.BoolValue(item.Ignored),
+				CheckPK:                    types.Int64Value(item.CheckPK),
+				CheckURL:                   types.StringValue(item.CheckURL),
+				CheckAddress:               types.StringValue(item.CheckAddress),
+				CheckName:                  types.StringValue(item.CheckName),
+				CheckMonitoringServiceType: types.StringValue(item.CheckMonitoringServiceType),
+			})
 		}
+		seen += int64(len(api.Items))
+		if int64(len(api.Items)) < listPageSize || seen >= api.TotalCount {
+			break
+		}
+	}
+	alerts, diags = Truncate("alerts", config.MaxItems, alerts)
+	rs.Diagnostics.Append(diags...)
+
+	model := AlertsDataSourceModel{
+		ID:               types.StringValue(""),
+		EventFilterModel: config.EventFilterModel,
+		Alerts:           alerts,
 	}
 
 	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
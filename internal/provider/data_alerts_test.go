@@ -14,5 +14,15 @@ func TestAccAlertsDataSource(t *testing.T) {
 				resource.TestCheckResourceAttr("data.uptime_alerts.test", "id", ""),
 			),
 		},
+		{
+			Config: `data "uptime_alerts" "test" {
+  start       = "-7d"
+  state_is_up = false
+  max_items   = 5
+}`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.uptime_alerts.test", "max_items", "5"),
+			),
+		},
 	}))
 }
@@ -22,6 +22,7 @@ var CheckGroupsDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"check_groups": schema.ListNestedAttribute{
 			Computed:    true,
 			Description: "List of all check groups in the account",
@@ -121,6 +122,7 @@ var CheckGroupsDataSchema = schema.Schema{
 
 type CheckGroupsDataSourceModel struct {
 	ID          types.String                     `tfsdk:"id"`
+	MaxItems    types.Int64                      `tfsdk:"max_items"`
 	CheckGroups []CheckGroupsDataSourceItemModel `tfsdk:"check_groups"`
 }
 
@@ -150,23 +152,38 @@ func (d CheckGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 	rs.Schema = CheckGroupsDataSchema
 }
 
-func (d CheckGroupsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, rs *datasource.ReadResponse) {
-	api, err := d.p.api.Checks().List(ctx, upapi.CheckListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+func (d CheckGroupsDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config CheckGroupsDataSourceModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	checks, diags := ListAll(ctx, "checks", types.Int64Null(), func(ctx context.Context, page, pageSize int64) ([]upapi.Check, int64, error) {
+		api, err := d.p.api.Checks().List(ctx, upapi.CheckListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
 	// Filter to only include check groups (checks with GroupConfig)
 	var checkGroups []upapi.Check
-	for _, check := range api.Items {
+	for _, check := range checks {
 		if check.GroupConfig != nil {
 			checkGroups = append(checkGroups, check)
 		}
 	}
+	checkGroups, diags = Truncate("check groups", config.MaxItems, checkGroups)
+	rs.Diagnostics.Append(diags...)
 
 	model := CheckGroupsDataSourceModel{
 		ID:          types.StringValue(""),
+		MaxItems:    config.MaxItems,
 		CheckGroups: make([]CheckGroupsDataSourceItemModel, len(checkGroups)),
 	}
 
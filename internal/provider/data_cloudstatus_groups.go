@@ -21,6 +21,7 @@ var CloudStatusGroupsDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"search": schema.StringAttribute{
 			Optional:    true,
 			Description: "Case-insensitive substring matched against the provider group name",
@@ -45,9 +46,10 @@ var CloudStatusGroupsDataSchema = schema.Schema{
 }
 
 type CloudStatusGroupsDataSourceModel struct {
-	ID     types.String                           `tfsdk:"id"`
-	Search types.String                           `tfsdk:"search"`
-	Groups []CloudStatusGroupsDataSourceItemModel `tfsdk:"groups"`
+	ID       types.String                           `tfsdk:"id"`
+	MaxItems types.Int64                            `tfsdk:"max_items"`
+	Search   types.String                           `tfsdk:"search"`
+	Groups   []CloudStatusGroupsDataSourceItemModel `tfsdk:"groups"`
 }
 
 type CloudStatusGroupsDataSourceItemModel struct {
@@ -76,39 +78,27 @@ func (d CloudStatusGroupsDataSource) Read(ctx context.Context, rq datasource.Rea
 		return
 	}
 
-	const pageSize int64 = 100
-	const maxPages int64 = 1000
-	var items []upapi.CloudStatusGroupListItem
-	for page := int64(1); page <= maxPages; page++ {
+	items, diags := ListAll(ctx, "CloudStatus groups", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.CloudStatusGroupListItem, int64, error) {
 		api, err := d.p.api.Checks().ListCloudStatusGroups(ctx, upapi.CloudStatusGroupListOptions{
 			Page:     page,
 			PageSize: pageSize,
 			Search:   config.Search.ValueString(),
 		})
 		if err != nil {
-			rs.Diagnostics.AddError(
-				"uptime_cloudstatus_groups read failed",
-				fmt.Sprintf("page=%d search=%q: %s", page, config.Search.ValueString(), err),
-			)
-			return
-		}
-		items = append(items, api.Items...)
-		if int64(len(api.Items)) < pageSize || int64(len(items)) >= api.TotalCount {
-			break
-		}
-		if page == maxPages {
-			rs.Diagnostics.AddError(
-				"uptime_cloudstatus_groups read aborted",
-				fmt.Sprintf("paginated past %d pages without reaching reported total %d - server may be returning inconsistent counts", maxPages, api.TotalCount),
-			)
-			return
+			return nil, 0, fmt.Errorf("page=%d search=%q: %w", page, config.Search.ValueString(), err)
 		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
+		return
 	}
 
 	model := CloudStatusGroupsDataSourceModel{
-		ID:     types.StringValue(""),
-		Search: config.Search,
-		Groups: make([]CloudStatusGroupsDataSourceItemModel, len(items)),
+		ID:       types.StringValue(""),
+		MaxItems: config.MaxItems,
+		Search:   config.Search,
+		Groups:   make([]CloudStatusGroupsDataSourceItemModel, len(items)),
 	}
 	for i := range items {
 		model.Groups[i] = CloudStatusGroupsDataSourceItemModel{
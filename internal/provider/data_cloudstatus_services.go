@@ -21,6 +21,7 @@ var CloudStatusServicesDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"group": schema.StringAttribute{
 			Optional:    true,
 			Description: "Provider group ID or case-insensitive name substring. Strongly recommended because the services list is large; without it you will fetch every service across every provider.",
@@ -66,6 +67,7 @@ var CloudStatusServicesDataSchema = schema.Schema{
 
 type CloudStatusServicesDataSourceModel struct {
 	ID       types.String                             `tfsdk:"id"`
+	MaxItems types.Int64                              `tfsdk:"max_items"`
 	Group    types.String                             `tfsdk:"group"`
 	Search   types.String                             `tfsdk:"search"`
 	Services []CloudStatusServicesDataSourceItemModel `tfsdk:"services"`
@@ -101,10 +103,7 @@ func (d CloudStatusServicesDataSource) Read(ctx context.Context, rq datasource.R
 		return
 	}
 
-	const pageSize int64 = 100
-	const maxPages int64 = 1000
-	var items []upapi.CloudStatusService
-	for page := int64(1); page <= maxPages; page++ {
+	items, diags := ListAll(ctx, "CloudStatus services", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.CloudStatusService, int64, error) {
 		api, err := d.p.api.Checks().ListCloudStatusServices(ctx, upapi.CloudStatusServiceListOptions{
 			Page:     page,
 			PageSize: pageSize,
@@ -112,28 +111,19 @@ func (d CloudStatusServicesDataSource) Read(ctx context.Context, rq datasource.R
 			Search:   config.Search.ValueString(),
 		})
 		if err != nil {
-			rs.Diagnostics.AddError(
-				"uptime_cloudstatus_services read failed",
-				fmt.Sprintf("page=%d group=%q search=%q: %s",
-					page, config.Group.ValueString(), config.Search.ValueString(), err),
-			)
-			return
-		}
-		items = append(items, api.Items...)
-		if int64(len(api.Items)) < pageSize || int64(len(items)) >= api.TotalCount {
-			break
-		}
-		if page == maxPages {
-			rs.Diagnostics.AddError(
-				"uptime_cloudstatus_services read aborted",
-				fmt.Sprintf("paginated past %d pages without reaching reported total %d - server may be returning inconsistent counts", maxPages, api.TotalCount),
-			)
-			return
+			return nil, 0, fmt.Errorf("page=%d group=%q search=%q: %w",
+				page, config.Group.ValueString(), config.Search.ValueString(), err)
 		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
+		return
 	}
 
 	model := CloudStatusServicesDataSourceModel{
 		ID:       types.StringValue(""),
+		MaxItems: config.MaxItems,
 		Group:    config.Group,
 		Search:   config.Search,
 		Services: make([]CloudStatusServicesDataSourceItemModel, len(items)),
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func NewContactDataSource(_ context.Context, p *providerImpl) datasource.DataSource {
+	return ContactDataSource{p: p}
+}
+
+// ContactDataSchema defines the schema for the contact data source.
+var ContactDataSchema = schema.Schema{
+	Description: "Look up a single contact by name or ID.",
+	Attributes: LookupSchemaAttributes(
+		ContactsDataSchema.Attributes["contacts"].(schema.ListNestedAttribute).NestedObject.Attributes,
+		"id", "name",
+	),
+}
+
+var (
+	_ datasource.DataSource                     = &ContactDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &ContactDataSource{}
+)
+
+type ContactDataSource struct {
+	p *providerImpl
+}
+
+func (d ContactDataSource) Metadata(_ context.Context, rq datasource.MetadataRequest, rs *datasource.MetadataResponse) {
+	rs.TypeName = rq.ProviderTypeName + "_contact"
+}
+
+func (d ContactDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, rs *datasource.SchemaResponse) {
+	rs.Schema = ContactDataSchema
+}
+
+func (d ContactDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return LookupConfigValidators("id", "name")
+}
+
+func (d ContactDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config ContactsDataSourceItemModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	var obj *upapi.Contact
+	if !config.ID.IsNull() {
+		var err error
+		obj, err = d.p.api.Contacts().Get(ctx, upapi.PrimaryKey(config.ID.ValueInt64()))
+		if err != nil {
+			rs.Diagnostics.AddError("API call failed", err.Error())
+			return
+		}
+	} else {
+		items, diags := ListAll(ctx, "contacts", types.Int64Null(), func(ctx context.Context, page, pageSize int64) ([]upapi.Contact, int64, error) {
+			api, err := d.p.api.Contacts().List(ctx, upapi.ContactListOptions{Page: page, PageSize: pageSize})
+			if err != nil {
+				return nil, 0, err
+			}
+			return api.Items, api.TotalCount, nil
+		})
+		rs.Diagnostics.Append(diags...)
+		if rs.Diagnostics.HasError() {
+			return
+		}
+		name := config.Name.ValueString()
+		found, diags := LookupOne("contact", "name", name, items,
+			func(c upapi.Contact) bool { return c.Name == name },
+			func(c upapi.Contact) string { return fmt.Sprintf("ID %d", c.PK) },
+		)
+		rs.Diagnostics.Append(diags...)
+		if rs.Diagnostics.HasError() {
+			return
+		}
+		obj = found
+	}
+
+	rs.Diagnostics.Append(rs.State.Set(ctx, contactItemModel(*obj))...)
+}
@@ -22,6 +22,7 @@ var ContactsDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"contacts": schema.ListNestedAttribute{
 			Computed:    true,
 			Description: "List of all contacts in the account",
@@ -72,6 +73,7 @@ var ContactsDataSchema = schema.Schema{
 
 type ContactsDataSourceModel struct {
 	ID       types.String                  `tfsdk:"id"`
+	MaxItems types.Int64                   `tfsdk:"max_items"`
 	Contacts []ContactsDataSourceItemModel `tfsdk:"contacts"`
 }
 
@@ -100,76 +102,93 @@ func (d ContactsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 	rs.Schema = ContactsDataSchema
 }
 
-func (d ContactsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, rs *datasource.ReadResponse) {
-	api, err := d.p.api.Contacts().List(ctx, upapi.ContactListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+func (d ContactsDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config ContactsDataSourceModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := ListAll(ctx, "contacts", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.Contact, int64, error) {
+		api, err := d.p.api.Contacts().List(ctx, upapi.ContactListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
 	model := ContactsDataSourceModel{
 		ID:       types.StringValue(""),
-		Contacts: make([]ContactsDataSourceItemModel, len(api.Items)),
+		MaxItems: config.MaxItems,
+		Contacts: make([]ContactsDataSourceItemModel, len(items)),
 	}
 
-	for i := range api.Items {
-		// Convert string slices to types.List
-		smsListValues := make([]attr.Value, len(api.Items[i].SmsList))
-		for j, v := range api.Items[i].SmsList {
-			smsListValues[j] = types.StringValue(v)
-		}
-		smsList := types.ListNull(types.StringType)
-		if len(smsListValues) > 0 {
-			smsList = types.ListValueMust(types.StringType, smsListValues)
-		}
+	for i := range items {
+		model.Contacts[i] = contactItemModel(items[i])
+	}
 
-		emailListValues := make([]attr.Value, len(api.Items[i].EmailList))
-		for j, v := range api.Items[i].EmailList {
-			emailListValues[j] = types.StringValue(v)
-		}
-		emailList := types.ListNull(types.StringType)
-		if len(emailListValues) > 0 {
-			emailList = types.ListValueMust(types.StringType, emailListValues)
-		}
+	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
+}
 
-		phonecallListValues := make([]attr.Value, len(api.Items[i].PhonecallList))
-		for j, v := range api.Items[i].PhonecallList {
-			phonecallListValues[j] = types.StringValue(v)
-		}
-		phonecallList := types.ListNull(types.StringType)
-		if len(phonecallListValues) > 0 {
-			phonecallList = types.ListValueMust(types.StringType, phonecallListValues)
-		}
+func contactItemModel(obj upapi.Contact) ContactsDataSourceItemModel {
+	// Convert string slices to types.List
+	smsListValues := make([]attr.Value, len(obj.SmsList))
+	for j, v := range obj.SmsList {
+		smsListValues[j] = types.StringValue(v)
+	}
+	smsList := types.ListNull(types.StringType)
+	if len(smsListValues) > 0 {
+		smsList = types.ListValueMust(types.StringType, smsListValues)
+	}
 
-		integrationsValues := make([]attr.Value, len(api.Items[i].Integrations))
-		for j, v := range api.Items[i].Integrations {
-			integrationsValues[j] = types.StringValue(v)
-		}
-		integrations := types.ListNull(types.StringType)
-		if len(integrationsValues) > 0 {
-			integrations = types.ListValueMust(types.StringType, integrationsValues)
-		}
+	emailListValues := make([]attr.Value, len(obj.EmailList))
+	for j, v := range obj.EmailList {
+		emailListValues[j] = types.StringValue(v)
+	}
+	emailList := types.ListNull(types.StringType)
+	if len(emailListValues) > 0 {
+		emailList = types.ListValueMust(types.StringType, emailListValues)
+	}
 
-		pushProfilesValues := make([]attr.Value, len(api.Items[i].PushNotificationProfiles))
-		for j, v := range api.Items[i].PushNotificationProfiles {
-			pushProfilesValues[j] = types.StringValue(v)
-		}
-		pushProfiles := types.ListNull(types.StringType)
-		if len(pushProfilesValues) > 0 {
-			pushProfiles = types.ListValueMust(types.StringType, pushProfilesValues)
-		}
+	phonecallListValues := make([]attr.Value, len(obj.PhonecallList))
+	for j, v := range obj.PhonecallList {
+		phonecallListValues[j] = types.StringValue(v)
+	}
+	phonecallList := types.ListNull(types.StringType)
+	if len(phonecallListValues) > 0 {
+		phonecallList = types.ListValueMust(types.StringType, phonecallListValues)
+	}
 
-		model.Contacts[i] = ContactsDataSourceItemModel{
-			ID:                       types.Int64Value(api.Items[i].PK),
-			URL:                      types.StringValue(api.Items[i].URL),
-			Name:                     types.StringValue(api.Items[i].Name),
-			SMSList:                  smsList,
-			EmailList:                emailList,
-			PhonecallList:            phonecallList,
-			Integrations:             integrations,
-			PushNotificationProfiles: pushProfiles,
-		}
+	integrationsValues := make([]attr.Value, len(obj.Integrations))
+	for j, v := range obj.Integrations {
+		integrationsValues[j] = types.StringValue(v)
+	}
+	integrations := types.ListNull(types.StringType)
+	if len(integrationsValues) > 0 {
+		integrations = types.ListValueMust(types.StringType, integrationsValues)
 	}
 
-	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
+	pushProfilesValues := make([]attr.Value, len(obj.PushNotificationProfiles))
+	for j, v := range obj.PushNotificationProfiles {
+		pushProfilesValues[j] = types.StringValue(v)
+	}
+	pushProfiles := types.ListNull(types.StringType)
+	if len(pushProfilesValues) > 0 {
+		pushProfiles = types.ListValueMust(types.StringType, pushProfilesValues)
+	}
+
+	return ContactsDataSourceItemModel{
+		ID:                       types.Int64Value(obj.PK),
+		URL:                      types.StringValue(obj.URL),
+		Name:                     types.StringValue(obj.Name),
+		SMSList:                  smsList,
+		EmailList:                emailList,
+		PhonecallList:            phonecallList,
+		Integrations:             integrations,
+		PushNotificationProfiles: pushProfiles,
+	}
 }
@@ -22,6 +22,7 @@ var CredentialsDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"credentials": schema.ListNestedAttribute{
 			Computed:    true,
 			Description: "List of all credentials in the account",
@@ -72,6 +73,7 @@ var CredentialsDataSchema = schema.Schema{
 
 type CredentialsDataSourceModel struct {
 	ID          types.String                     `tfsdk:"id"`
+	MaxItems    types.Int64                      `tfsdk:"max_items"`
 	Credentials []CredentialsDataSourceItemModel `tfsdk:"credentials"`
 }
 
@@ -101,24 +103,37 @@ func (d CredentialsDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 	rs.Schema = CredentialsDataSchema
 }
 
-func (d CredentialsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, rs *datasource.ReadResponse) {
-	api, err := d.p.api.Credentials().List(ctx, upapi.CredentialListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+func (d CredentialsDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config CredentialsDataSourceModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := ListAll(ctx, "credentials", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.Credential, int64, error) {
+		api, err := d.p.api.Credentials().List(ctx, upapi.CredentialListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
 	model := CredentialsDataSourceModel{
 		ID:          types.StringValue(""),
-		Credentials: make([]CredentialsDataSourceItemModel, len(api.Items)),
+		MaxItems:    config.MaxItems,
+		Credentials: make([]CredentialsDataSourceItemModel, len(items)),
 	}
 
-	for i := range api.Items {
+	for i := range items {
 		// Convert UsedSecretProperties to types.List
 		var usedSecretPropsList types.List
-		if len(api.Items[i].UsedSecretProperties) > 0 {
-			elements := make([]attr.Value, len(api.Items[i].UsedSecretProperties))
-			for j, prop := range api.Items[i].UsedSecretProperties {
+		if len(items[i].UsedSecretProperties) > 0 {
+			elements := make([]attr.Value, len(items[i].UsedSecretProperties))
+			for j, prop := range items[i].UsedSecretProperties {
 				elements[j] = types.StringValue(prop)
 			}
 			usedSecretPropsList = types.ListValueMust(types.StringType, elements)
@@ -127,17 +142,17 @@ func (d CredentialsDataSource) Read(ctx context.Context, _ datasource.ReadReques
 		}
 
 		model.Credentials[i] = CredentialsDataSourceItemModel{
-			ID:                   types.Int64Value(api.Items[i].PK),
-			DisplayName:          types.StringValue(api.Items[i].DisplayName),
-			Description:          types.StringValue(api.Items[i].Description),
-			CredentialType:       types.StringValue(api.Items[i].CredentialType),
-			Hint:                 types.StringValue(api.Items[i].Hint),
-			Username:             types.StringValue(api.Items[i].Username),
-			Version:              types.StringValue(api.Items[i].Version),
+			ID:                   types.Int64Value(items[i].PK),
+			DisplayName:          types.StringValue(items[i].DisplayName),
+			Description:          types.StringValue(items[i].Description),
+			CredentialType:       types.StringValue(items[i].CredentialType),
+			Hint:                 types.StringValue(items[i].Hint),
+			Username:             types.StringValue(items[i].Username),
+			Version:              types.StringValue(items[i].Version),
 			UsedSecretProperties: usedSecretPropsList,
-			CreatedBy:            types.Int64Value(api.Items[i].CreatedBy),
+			CreatedBy:            types.Int64Value(items[i].CreatedBy),
 		}
 	}
 
-	rs.Diagnostics = rs.State.Set(ctx, model)
+	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
 }
@@ -22,6 +22,7 @@ var DashboardsDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"dashboards": schema.ListNestedAttribute{
 			Computed:    true,
 			Description: "List of all dashboards in the account",
@@ -117,6 +118,7 @@ var DashboardsDataSchema = schema.Schema{
 
 type DashboardsDataSourceModel struct {
 	ID         types.String                    `tfsdk:"id"`
+	MaxItems   types.Int64                     `tfsdk:"max_items"`
 	Dashboards []DashboardsDataSourceItemModel `tfsdk:"dashboards"`
 }
 
@@ -157,22 +159,35 @@ func (d DashboardsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 	rs.Schema = DashboardsDataSchema
 }
 
-func (d DashboardsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, rs *datasource.ReadResponse) {
-	api, err := d.p.api.Dashboards().List(ctx, upapi.DashboardListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+func (d DashboardsDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config DashboardsDataSourceModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := ListAll(ctx, "dashboards", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.Dashboard, int64, error) {
+		api, err := d.p.api.Dashboards().List(ctx, upapi.DashboardListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
 	model := DashboardsDataSourceModel{
 		ID:         types.StringValue(""),
-		Dashboards: make([]DashboardsDataSourceItemModel, len(api.Items)),
+		MaxItems:   config.MaxItems,
+		Dashboards: make([]DashboardsDataSourceItemModel, len(items)),
 	}
 
-	for i := range api.Items {
+	for i := range items {
 		// Convert ServicesSelected slice to types.List
-		servicesSelectedValues := make([]attr.Value, len(api.Items[i].ServicesSelected))
-		for j, v := range api.Items[i].ServicesSelected {
+		servicesSelectedValues := make([]attr.Value, len(items[i].ServicesSelected))
+		for j, v := range items[i].ServicesSelected {
 			servicesSelectedValues[j] = types.StringValue(v)
 		}
 		servicesSelected := types.ListNull(types.StringType)
@@ -181,8 +196,8 @@ func (d DashboardsDataSource) Read(ctx context.Context, _ datasource.ReadRequest
 		}
 
 		// Convert ServicesTags slice to types.List
-		servicesTagsValues := make([]attr.Value, len(api.Items[i].ServicesTags))
-		for j, v := range api.Items[i].ServicesTags {
+		servicesTagsValues := make([]attr.Value, len(items[i].ServicesTags))
+		for j, v := range items[i].ServicesTags {
 			servicesTagsValues[j] = types.StringValue(v)
 		}
 		servicesTags := types.ListNull(types.StringType)
@@ -191,26 +206,26 @@ func (d DashboardsDataSource) Read(ctx context.Context, _ datasource.ReadRequest
 		}
 
 		model.Dashboards[i] = DashboardsDataSourceItemModel{
-			ID:                         types.Int64Value(api.Items[i].PK),
-			Name:                       types.StringValue(api.Items[i].Name),
-			Ordering:                   types.Int64Value(api.Items[i].Ordering),
-			IsPinned:                   types.BoolValue(api.Items[i].IsPinned),
+			ID:                         types.Int64Value(items[i].PK),
+			Name:                       types.StringValue(items[i].Name),
+			Ordering:                   types.Int64Value(items[i].Ordering),
+			IsPinned:                   types.BoolValue(items[i].IsPinned),
 			ServicesSelected:           servicesSelected,
 			ServicesTags:               servicesTags,
-			MetricsShowSection:         types.BoolValue(api.Items[i].MetricsShowSection),
-			MetricsForAllChecks:        types.BoolValue(api.Items[i].MetricsForAllChecks),
-			ServicesShowSection:        types.BoolValue(api.Items[i].ServicesShowSection),
-			ServicesNumToShow:          types.Int64Value(api.Items[i].ServicesNumToShow),
-			ServicesIncludeUp:          types.BoolValue(api.Items[i].ServicesIncludeUp),
-			ServicesIncludeDown:        types.BoolValue(api.Items[i].ServicesIncludeDown),
-			ServicesIncludePaused:      types.BoolValue(api.Items[i].ServicesIncludePaused),
-			ServicesIncludeMaintenance: types.BoolValue(api.Items[i].ServicesIncludeMaintenance),
-			ServicesPrimarySort:        types.StringValue(api.Items[i].ServicesPrimarySort),
-			ServicesSecondarySort:      types.StringValue(api.Items[i].ServicesSecondarySort),
-			ServicesShowUptime:         types.BoolValue(api.Items[i].ServicesShowUptime),
-			ServicesShowResponseTime:   types.BoolValue(api.Items[i].ServicesShowResponseTime),
-			AlertsShowSection:          types.BoolValue(api.Items[i].AlertsShowSection),
-			AlertsForAllChecks:         types.BoolValue(api.Items[i].AlertsForAllChecks),
+			MetricsShowSection:         types.BoolValue(items[i].MetricsShowSection),
+			MetricsForAllChecks:        types.BoolValue(items[i].MetricsForAllChecks),
+			ServicesShowSection:        types.BoolValue(items[i].ServicesShowSection),
+			ServicesNumToShow:          types.Int64Value(items[i].ServicesNumToShow),
+			ServicesIncludeUp:          types.BoolValue(items[i].ServicesIncludeUp),
+			ServicesIncludeDown:        types.BoolValue(items[i].ServicesIncludeDown),
+			ServicesIncludePaused:      types.BoolValue(items[i].ServicesIncludePaused),
+			ServicesIncludeMaintenance: types.BoolValue(items[i].ServicesIncludeMaintenance),
+			ServicesPrimarySort:        types.StringValue(items[i].ServicesPrimarySort),
+			ServicesSecondarySort:      types.StringValue(items[i].ServicesSecondarySort),
+			ServicesShowUptime:         types.BoolValue(items[i].ServicesShowUptime),
+			ServicesShowResponseTime:   types.BoolValue(items[i].ServicesShowResponseTime),
+			AlertsShowSection:          types.BoolValue(items[i].AlertsShowSection),
+			AlertsForAllChecks:         types.BoolValue(items[i].AlertsForAllChecks),
 		}
 	}
 
@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+// EventFilterSchemaAttributes adds the filter arguments shared by the alerts
+// and outages data sources to attrs.
+func EventFilterSchemaAttributes(noun string, attrs map[string]schema.Attribute) map[string]schema.Attribute {
+	attrs["check_id"] = schema.Int64Attribute{
+		Optional:    true,
+		Description: fmt.Sprintf("Only return %s of the check with this ID", noun),
+	}
+	attrs["tag"] = schema.StringAttribute{
+		Optional:    true,
+		Description: fmt.Sprintf("Only return %s of checks with this tag", noun),
+	}
+	attrs["start"] = schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			TimeValidator(),
+		},
+		Description: fmt.Sprintf("Only return %s created at or after this time. "+
+			"Accepts an RFC 3339 timestamp, a date (`2006-01-02`), `now`, or a duration relative to now such as `-7d`, `-12h` or `-2w`", noun),
+	}
+	attrs["end"] = schema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			TimeValidator(),
+		},
+		Description: fmt.Sprintf("Only return %s created before this time. Accepts the same formats as `start`", noun),
+	}
+	attrs["state_is_up"] = schema.BoolAttribute{
+		Optional:    true,
+		Description: fmt.Sprintf("Only return %s with this check state", noun),
+	}
+	attrs["ignored"] = schema.BoolAttribute{
+		Optional:    true,
+		Description: fmt.Sprintf("Only return ignored (`true`) or not ignored (`false`) %s", noun),
+	}
+	attrs["max_items"] = MaxItemsSchemaAttribute()
+	return attrs
+}
+
+type EventFilterModel struct {
+	CheckID   types.Int64  `tfsdk:"check_id"`
+	Tag       types.String `tfsdk:"tag"`
+	Start     types.String `tfsdk:"start"`
+	End       types.String `tfsdk:"end"`
+	StateIsUp types.Bool   `tfsdk:"state_is_up"`
+	Ignored   types.Bool   `tfsdk:"ignored"`
+	MaxItems  types.Int64  `tfsdk:"max_items"`
+}
+
+// TimeRange resolves start and end against now. Unset bounds are returned as
+// zero times.
+func (m EventFilterModel) TimeRange(now time.Time) (start, end time.Time, diags diag.Diagnostics) {
+	if !m.Start.IsNull() {
+		t, err := parseTimeArgument(m.Start.ValueString(), now)
+		if err != nil {
+			diags.AddAttributeError(path.Root("start"), "Invalid value", err.Error())
+			return time.Time{}, time.Time{}, diags
+		}
+		start = t
+	}
+	if !m.End.IsNull() {
+		t, err := parseTimeArgument(m.End.ValueString(), now)
+		if err != nil {
+			diags.AddAttributeError(path.Root("end"), "Invalid value", err.Error())
+			return time.Time{}, time.Time{}, diags
+		}
+		end = t
+	}
+	if !start.IsZero() && !end.IsZero() && !start.Before(end) {
+		diags.AddAttributeError(path.Root("end"), "Invalid value",
+			fmt.Sprintf("end (%s) must be after start (%s)", end.UTC().Format(time.RFC3339), start.UTC().Format(time.RFC3339)))
+	}
+	return start, end, diags
+}
+
+// EventFilter matches alerts and outages against the filter arguments. The
+// list endpoints are only paged through, so filtering happens here.
+type EventFilter struct {
+	EventFilterModel
+	start, end time.Time
+	tagged     map[int64]bool // IDs of the checks with Tag, when it is set
+}
+
+// Resolve prepares m for matching: it resolves start and end against now and
+// looks up the checks carrying Tag.
+func (m EventFilterModel) Resolve(ctx context.Context, api upapi.API, now time.Time) (*EventFilter, diag.Diagnostics) {
+	start, end, diags := m.TimeRange(now)
+	if diags.HasError() {
+		return nil, diags
+	}
+	f := EventFilter{EventFilterModel: m, start: start, end: end}
+	if m.Tag.IsNull() {
+		return &f, diags
+	}
+	f.tagged = make(map[int64]bool)
+	err := ListEach(ctx, listPageSize, func(ctx context.Context, page, pageSize int64) ([]upapi.Check, int64, error) {
+		res, err := api.Checks().List(ctx, upapi.CheckListOptions{
+			Page:     page,
+			PageSize: pageSize,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Items, res.TotalCount, nil
+	}, func(check upapi.Check) bool {
+		if slices.Contains(check.Tags, m.Tag.ValueString()) {
+			f.tagged[check.PK] = true
+		}
+		return true
+	})
+	if err != nil {
+		diags.AddError("API call failed", err.Error())
+		return nil, diags
+	}
+	return &f, diags
+}
+
+// Match reports whether an alert or outage with these attributes passes the
+// filter.
+func (f EventFilter) Match(checkPK int64, stateIsUp, ignored bool, createdAt time.Time) bool {
+	switch {
+	case !f.CheckID.IsNull() && checkPK != f.CheckID.ValueInt64():
+		return false
+	case f.tagged != nil && !f.tagged[checkPK]:
+		return false
+	case !f.StateIsUp.IsNull() && stateIsUp != f.StateIsUp.ValueBool():
+		return false
+	case !f.Ignored.IsNull() && ignored != f.Ignored.ValueBool():
+		return false
+	case !f.start.IsZero() && createdAt.Before(f.start):
+		return false
+	case !f.end.IsZero() && !createdAt.Before(f.end):
+		return false
+	}
+	return true
+}
+
+var relativeDaysRE = regexp.MustCompile(`^([+-]?)(\d+)([dw])$`)
+
+// parseTimeArgument parses an RFC 3339 timestamp, a date, "now", or a duration
+// relative to now: Go durations such as "-12h" or "-90m", plus days ("-7d")
+// and weeks ("-2w").
+func parseTimeArgument(s string, now time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "now" {
+		return now, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+	if m := relativeDaysRE.FindStringSubmatch(s); m != nil {
+		n, err := strconv.Atoi(m[2])
+		if err == nil {
+			if m[3] == "w" {
+				n *= 7
+			}
+			if m[1] == "-" {
+				n = -n
+			}
+			return now.AddDate(0, 0, n), nil
+		}
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(d), nil
+	}
+	return time.Time{}, fmt.Errorf("%q is not an RFC 3339 timestamp, a date (2006-01-02), \"now\" or a relative duration such as -7d", s)
+}
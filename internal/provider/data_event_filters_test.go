@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseTimeArgument(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		in      string
+		want    time.Time
+		wantErr bool
+	}{
+		{in: "now", want: now},
+		{in: "2024-03-01T08:30:00Z", want: time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC)},
+		{in: "2024-03-01", want: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{in: "-7d", want: now.AddDate(0, 0, -7)},
+		{in: "-2w", want: now.AddDate(0, 0, -14)},
+		{in: "-12h", want: now.Add(-12 * time.Hour)},
+		{in: "-1h30m", want: now.Add(-90 * time.Minute)},
+		{in: "yesterday", wantErr: true},
+		{in: "-7x", wantErr: true},
+	}
+	for _, c := range cases {
+		t.Run(c.in, func(t *testing.T) {
+			got, err := parseTimeArgument(c.in, now)
+			if c.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(c.want) {
+				t.Errorf("got %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestEventFilterModelTimeRange(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	m := EventFilterModel{
+		Start: types.StringValue("-1d"),
+		End:   types.StringValue("now"),
+	}
+	start, end, diags := m.TimeRange(now)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if !start.Equal(now.AddDate(0, 0, -1)) || !end.Equal(now) {
+		t.Errorf("got %v..%v", start, end)
+	}
+
+	m.Start, m.End = types.StringValue("now"), types.StringValue("-1d")
+	if _, _, diags := m.TimeRange(now); !diags.HasError() {
+		t.Error("expected an error for end before start")
+	}
+}
+
+func TestEventFilterMatch(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	f := EventFilter{
+		EventFilterModel: EventFilterModel{
+			CheckID:   types.Int64Null(),
+			StateIsUp: types.BoolValue(false),
+			Ignored:   types.BoolNull(),
+		},
+		start:  now.AddDate(0, 0, -1),
+		end:    now,
+		tagged: map[int64]bool{1: true, 2: true},
+	}
+	cases := []struct {
+		name      string
+		checkPK   int64
+		stateIsUp bool
+		createdAt time.Time
+		want      bool
+	}{
+		{"matches", 1, false, now.Add(-time.Hour), true},
+		{"untagged check", 3, false, now.Add(-time.Hour), false},
+		{"state", 1, true, now.Add(-time.Hour), false},
+		{"before start", 2, false, now.AddDate(0, 0, -2), false},
+		{"at end", 2, false, now, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := f.Match(c.checkPK, c.stateIsUp, false, c.createdAt); got != c.want {
+				t.Errorf("Match() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// LookupSchemaAttributes turns the computed item attributes of a list data
+// source into the attributes of a singular data source, making the given keys
+// optional lookup arguments.
+func LookupSchemaAttributes(item map[string]schema.Attribute, keys ...string) map[string]schema.Attribute {
+	out := make(map[string]schema.Attribute, len(item))
+	for k, v := range item {
+		out[k] = v
+	}
+	hint := fmt.Sprintf(". Exactly one of %s must be set", quotedList(keys))
+	for _, k := range keys {
+		switch a := out[k].(type) {
+		case schema.Int64Attribute:
+			a.Optional, a.Description = true, a.Description+hint
+			out[k] = a
+		case schema.StringAttribute:
+			a.Optional, a.Description = true, a.Description+hint
+			out[k] = a
+		default:
+			panic(fmt.Sprintf("unsupported lookup attribute %q", k))
+		}
+	}
+	return out
+}
+
+// LookupConfigValidators requires exactly one of the lookup keys to be set.
+func LookupConfigValidators(keys ...string) []datasource.ConfigValidator {
+	exprs := make([]path.Expression, len(keys))
+	for i, k := range keys {
+		exprs[i] = path.MatchRoot(k)
+	}
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(exprs...),
+	}
+}
+
+// LookupOne returns the only item matching value, and an error when there is
+// no match or more than one. describe identifies an item in the latter case.
+func LookupOne[T any](noun, key, value string, items []T, match func(T) bool, describe func(T) string) (*T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var found []*T
+	for i := range items {
+		if match(items[i]) {
+			found = append(found, &items[i])
+		}
+	}
+	switch len(found) {
+	case 1:
+		return found[0], nil
+	case 0:
+		diags.AddError(fmt.Sprintf("No %s found", noun),
+			fmt.Sprintf("No %s with %s %q exists in the account.", noun, key, value))
+		return nil, diags
+	default:
+		ids := make([]string, len(found))
+		for i := range found {
+			ids[i] = describe(*found[i])
+		}
+		sort.Strings(ids)
+		diags.AddError(fmt.Sprintf("Multiple %ss found", noun),
+			fmt.Sprintf("%d %ss have %s %q: %s. Look it up by ID instead.", len(found), noun, key, value, strings.Join(ids, ", ")))
+		return nil, diags
+	}
+}
+
+func quotedList(s []string) string {
+	var out string
+	for i := range s {
+		switch {
+		case i == 0:
+		case i == len(s)-1:
+			out += " or "
+		default:
+			out += ", "
+		}
+		out += "`" + s[i] + "`"
+	}
+	return out
+}
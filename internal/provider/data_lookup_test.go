@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	petname "github.com/dustinkirkland/golang-petname"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccLookupDataSources(t *testing.T) {
+	name := petname.Generate(3, "-")
+	resource.Test(t, testCaseFromSteps(t, []resource.TestStep{
+		{
+			ConfigDirectory: config.StaticDirectory("testdata/data_lookup"),
+			ConfigVariables: config.Variables{
+				"name": config.StringVariable(name),
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrPair("data.uptime_contact.by_name", "id", "uptime_contact.test", "id"),
+				resource.TestCheckResourceAttr("data.uptime_contact.by_id", "name", name),
+				resource.TestCheckResourceAttr("data.uptime_contact.by_id", "email_list.0", "noreply@example.com"),
+				resource.TestCheckResourceAttrPair("data.uptime_statuspage.by_name", "id", "uptime_statuspage.test", "id"),
+				resource.TestCheckResourceAttrPair("data.uptime_statuspage.by_slug", "id", "uptime_statuspage.test", "id"),
+			),
+		},
+		{
+			Config: fmt.Sprintf(`
+data uptime_contact missing {
+  name = %q
+}
+`, name+"-missing"),
+			ExpectError: regexp.MustCompile(`No contact found`),
+		},
+	}))
+}
+
+func TestLookupOne(t *testing.T) {
+	type item struct {
+		id   int
+		name string
+	}
+	items := []item{{1, "ops"}, {2, "sre"}, {3, "sre"}}
+	find := func(name string) (*item, string) {
+		found, diags := LookupOne("contact", "name", name, items,
+			func(i item) bool { return i.name == name },
+			func(i item) string { return fmt.Sprintf("ID %d", i.id) },
+		)
+		if diags.HasError() {
+			return found, diags[0].Summary() + ": " + diags[0].Detail()
+		}
+		return found, ""
+	}
+
+	if got, err := find("ops"); err != "" || got == nil || got.id != 1 {
+		t.Errorf("got %v, %q; want item 1", got, err)
+	}
+	if _, err := find("dev"); err != `No contact found: No contact with name "dev" exists in the account.` {
+		t.Errorf("unexpected error %q", err)
+	}
+	if _, err := find("sre"); err != `Multiple contacts found: 2 contacts have name "sre": ID 2, ID 3. Look it up by ID instead.` {
+		t.Errorf("unexpected error %q", err)
+	}
+}
+
+func TestQuotedList(t *testing.T) {
+	cases := map[string][]string{
+		"`id`":                   {"id"},
+		"`id` or `name`":         {"id", "name"},
+		"`id`, `name` or `slug`": {"id", "name", "slug"},
+	}
+	for want, in := range cases {
+		if got := quotedList(in); got != want {
+			t.Errorf("quotedList(%v) = %q, want %q", in, got, want)
+		}
+	}
+}
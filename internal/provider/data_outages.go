@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -16,7 +18,7 @@ func NewOutagesDataSource(_ context.Context, p *providerImpl) datasource.DataSou
 // OutagesDataSchema defines the schema for the outages data source.
 var OutagesDataSchema = schema.Schema{
 	Description: "Retrieve a list of outages from your Uptime.com account. Outages represent periods when a check was down.",
-	Attributes: map[string]schema.Attribute{
+	Attributes: EventFilterSchemaAttributes("outages", map[string]schema.Attribute{
 		"id": schema.StringAttribute{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
@@ -85,11 +87,12 @@ var OutagesDataSchema = schema.Schema{
 				},
 			},
 		},
-	},
+	}),
 }
 
 type OutagesDataSourceModel struct {
-	ID      types.String                 `tfsdk:"id"`
+	ID types.String `tfsdk:"id"`
+	EventFilterModel
 	Outages []OutagesDataSourceItemModel `tfsdk:"outages"`
 }
 
@@ -124,45 +127,79 @@ func (d OutagesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 	rs.Schema = OutagesDataSchema
 }
 
-func (d OutagesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, rs *datasource.ReadResponse) {
-	api, err := d.p.api.Outages().List(ctx, upapi.OutageListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+func (d OutagesDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config OutagesDataSourceModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
-
-	model := OutagesDataSourceModel{
-		ID:      types.StringValue(""),
-		Outages: make([]OutagesDataSourceItemModel, len(api.Items)),
+	filter, diags := config.EventFilterModel.Resolve(ctx, d.p.api, time.Now())
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
+		return
 	}
 
-	for i := range api.Items {
-		createdAt := ""
-		if !api.Items[i].CreatedAt.IsZero() {
-			createdAt = api.Items[i].CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+	outages := make([]OutagesDataSourceItemModel, 0)
+	var seen int64
+	for page := int64(1); ; page++ {
+		if page > listMaxPages {
+			rs.Diagnostics.AddError("API call failed",
+				fmt.Sprintf("Listing outages paged past %d pages without reaching the end. "+
+					"The server may be returning inconsistent counts.", listMaxPages))
+			return
 		}
-
-		resolvedAt := ""
-		if !api.Items[i].ResolvedAt.IsZero() {
-			resolvedAt = api.Items[i].ResolvedAt.Format("2006-01-02T15:04:05Z07:00")
+		api, err := d.p.api.Outages().List(ctx, upapi.OutageListOptions{
+			Page:     page,
+			PageSize: listPageSize,
+		})
+		if err != nil {
+			rs.Diagnostics.AddError("API call failed", err.Error())
+			return
 		}
-
-		model.Outages[i] = OutagesDataSourceItemModel{
-			ID:                         types.Int64Value(api.Items[i].PK),
-			URL:                        types.StringValue(api.Items[i].URL),
-			CreatedAt:                  types.StringValue(createdAt),
-			ResolvedAt:                 types.StringValue(resolvedAt),
-			DurationSecs:               types.Int64Value(api.Items[i].DurationSecs),
-			IgnoreAlertURL:             types.StringValue(api.Items[i].IgnoreAlertURL),
-			CheckPK:                    types.Int64Value(api.Items[i].CheckPK),
-			CheckURL:                   types.StringValue(api.Items[i].CheckURL),
-			CheckAddress:               types.StringValue(api.Items[i].CheckAddress),
-			CheckName:                  types.StringValue(api.Items[i].CheckName),
-			CheckMonitoringServiceType: types.StringValue(api.Items[i].CheckMonitoringServiceType),
-			StateIsUp:                  types.BoolValue(api.Items[i].StateIsUp),
-			Ignored:                    types.BoolValue(api.Items[i].Ignored),
-			NumLocationsDown:           types.Int64Value(api.Items[i].NumLocationsDown),
+		for _, item := range api.Items {
+			if !filter.Match(item.CheckPK, item.StateIsUp, item.Ignored, item.CreatedAt) {
+				continue
+			}
+
+			createdAt := ""
+			if !item.CreatedAt.IsZero() {
+				createdAt = item.CreatedAt.Format("2006-01-02T15:04:05Z07:00")
+			}
+
+			resolvedAt := ""
+			if !item.ResolvedAt.IsZero() {
+				resolvedAt = item.ResolvedAt.Format("2006-01-02T15:04:05Z07:00")
+			}
+
+			outages = append(outages, OutagesDataSourceItemModel{
+				ID:                         types.Int64Value(item.PK),
+				URL:                        types.StringValue(item.URL),
+				CreatedAt:                  types.StringValue(createdAt),
+				ResolvedAt:                 types.StringValue(resolvedAt),
+				DurationSecs:               types.Int64Value(item.DurationSecs),
+				IgnoreAlertURL:             types.StringValue(item.IgnoreAlertURL),
+				CheckPK:                    types.Int64Value(item.CheckPK),
+				CheckURL:                   types.StringValue(item.CheckURL),
+				CheckAddress:               types.StringValue(item.CheckAddress),
+				CheckName:                  types.StringValue(item.CheckName),
+				CheckMonitoringServiceType: types.StringValue(item.CheckMonitoringServiceType),
+				StateIsUp:                  types.BoolValue(item.StateIsUp),
+				Ignored:                    types.BoolValue(item.Ignored),
+				NumLocationsDown:           types.Int64Value(item.NumLocationsDown),
+			})
 		}
+		seen += int64(len(api.Items))
+		if int64(len(api.Items)) < listPageSize || seen >= api.TotalCount {
+			break
+		}
+	}
+	outages, diags = Truncate("outages", config.MaxItems, outages)
+	rs.Diagnostics.Append(diags...)
+
+	model := OutagesDataSourceModel{
+		ID:               types.StringValue(""),
+		EventFilterModel: config.EventFilterModel,
+		Outages:          outages,
 	}
 
 	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
@@ -14,5 +14,15 @@ func TestAccOutagesDataSource(t *testing.T) {
 				resource.TestCheckResourceAttr("data.uptime_outages.test", "id", ""),
 			),
 		},
+		{
+			Config: `data "uptime_outages" "test" {
+  start       = "-7d"
+  state_is_up = false
+  max_items   = 5
+}`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.uptime_outages.test", "max_items", "5"),
+			),
+		},
 	}))
 }
@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	listPageSize int64 = 100
+	listMaxPages int64 = 1000
+)
+
+// MaxItemsSchemaAttribute is the optional max_items argument of list data
+// sources.
+func MaxItemsSchemaAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+		Description: "Maximum number of items to return. When more items exist, the first `max_items` are returned " +
+			"and a warning is shown. By default all items are returned",
+	}
+}
+
+// ListPageFunc fetches one page of a list endpoint, returning its items and
+// the total number of items across all pages.
+type ListPageFunc[T any] func(ctx context.Context, page, pageSize int64) ([]T, int64, error)
+
+// ListAll pages through a list endpoint until every item has been read or
+// maxItems is reached. Stopping at maxItems with more items left produces a
+// warning naming noun, so results are never truncated silently.
+func ListAll[T any](ctx context.Context, noun string, maxItems types.Int64, fetch ListPageFunc[T]) ([]T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	limit := int64(-1)
+	pageSize := listPageSize
+	if !maxItems.IsNull() && !maxItems.IsUnknown() {
+		limit = maxItems.ValueInt64()
+		pageSize = min(pageSize, limit)
+	}
+
+	var items []T
+	for page := int64(1); ; page++ {
+		if page > listMaxPages {
+			diags.AddError("API call failed",
+				fmt.Sprintf("Listing %s paged past %d pages without reaching the end. "+
+					"The server may be returning inconsistent counts.", noun, listMaxPages))
+			return nil, diags
+		}
+		batch, total, err := fetch(ctx, page, pageSize)
+		if err != nil {
+			diags.AddError("API call failed", err.Error())
+			return nil, diags
+		}
+		items = append(items, batch...)
+		done := int64(len(batch)) < pageSize || int64(len(items)) >= total
+		if limit >= 0 && int64(len(items)) >= limit {
+			if int64(len(items)) > limit || !done {
+				diags.Append(truncationWarning(noun, limit, max(total, int64(len(items)))))
+			}
+			return items[:limit], diags
+		}
+		if done {
+			return items, diags
+		}
+	}
+}
+
+// ListEach pages through a list endpoint like ListAll, but passes items to
+// yield as they arrive instead of collecting them. It stops early when yield
+// returns false.
+func ListEach[T any](ctx context.Context, pageSize int64, fetch ListPageFunc[T], yield func(T) bool) error {
+	var seen int64
+	for page := int64(1); page <= listMaxPages; page++ {
+		batch, total, err := fetch(ctx, page, pageSize)
+		if err != nil {
+			return err
+		}
+		for _, item := range batch {
+			if !yield(item) {
+				return nil
+			}
+		}
+		seen += int64(len(batch))
+		if int64(len(batch)) < pageSize || seen >= total {
+			return nil
+		}
+	}
+	return fmt.Errorf("listing paged past %d pages without reaching the end; "+
+		"the server may be returning inconsistent counts", listMaxPages)
+}
+
+// Truncate applies maxItems to items that were filtered after listing, with
+// the same warning as ListAll.
+func Truncate[T any](noun string, maxItems types.Int64, items []T) ([]T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if maxItems.IsNull() || maxItems.IsUnknown() || int64(len(items)) <= maxItems.ValueInt64() {
+		return items, diags
+	}
+	limit := maxItems.ValueInt64()
+	diags.Append(truncationWarning(noun, limit, int64(len(items))))
+	return items[:limit], diags
+}
+
+// TruncateTotal applies maxItems to items read from a list endpoint that
+// reported total items, for loops that stop paging once maxItems are read.
+func TruncateTotal[T any](noun string, maxItems types.Int64, items []T, total int64) ([]T, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if maxItems.IsNull() || maxItems.IsUnknown() {
+		return items, diags
+	}
+	limit := maxItems.ValueInt64()
+	total = max(total, int64(len(items)))
+	if total > limit {
+		diags.Append(truncationWarning(noun, limit, total))
+	}
+	if int64(len(items)) > limit {
+		items = items[:limit]
+	}
+	return items, diags
+}
+
+// listLimit returns maxItems, or -1 when it isn't set.
+func listLimit(maxItems types.Int64) int64 {
+	if maxItems.IsNull() || maxItems.IsUnknown() {
+		return -1
+	}
+	return maxItems.ValueInt64()
+}
+
+func truncationWarning(noun string, limit, total int64) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(fmt.Sprintf("Truncated %s", noun),
+		fmt.Sprintf("Returning the first %d of %d %s. Raise or remove max_items to read them all.", limit, total, noun))
+}
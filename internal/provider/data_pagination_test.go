@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestListAll(t *testing.T) {
+	all := make([]int, 250)
+	for i := range all {
+		all[i] = i
+	}
+	fetch := func(calls *int) ListPageFunc[int] {
+		return func(_ context.Context, page, pageSize int64) ([]int, int64, error) {
+			*calls++
+			start := min((page-1)*pageSize, int64(len(all)))
+			end := min(start+pageSize, int64(len(all)))
+			return all[start:end], int64(len(all)), nil
+		}
+	}
+
+	cases := []struct {
+		name      string
+		maxItems  types.Int64
+		wantLen   int
+		wantCalls int
+		wantWarn  bool
+	}{
+		{"reads every page", types.Int64Null(), 250, 3, false},
+		{"stops at max_items", types.Int64Value(120), 120, 2, true},
+		{"small max_items uses a small page", types.Int64Value(5), 5, 1, true},
+		{"max_items equal to total", types.Int64Value(250), 250, 3, false},
+		{"max_items above total", types.Int64Value(1000), 250, 3, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var calls int
+			got, diags := ListAll(context.Background(), "items", c.maxItems, fetch(&calls))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if len(got) != c.wantLen || got[len(got)-1] != c.wantLen-1 {
+				t.Errorf("got %d items, want %d", len(got), c.wantLen)
+			}
+			if calls != c.wantCalls {
+				t.Errorf("got %d calls, want %d", calls, c.wantCalls)
+			}
+			if warn := diags.WarningsCount() > 0; warn != c.wantWarn {
+				t.Errorf("got warning %v, want %v: %v", warn, c.wantWarn, diags)
+			}
+		})
+	}
+
+	t.Run("reports API errors", func(t *testing.T) {
+		_, diags := ListAll(context.Background(), "items", types.Int64Null(),
+			func(context.Context, int64, int64) ([]int, int64, error) { return nil, 0, errors.New("boom") })
+		if !diags.HasError() || diags[0].Detail() != "boom" {
+			t.Errorf("unexpected diagnostics: %v", diags)
+		}
+	})
+
+	t.Run("stops on inconsistent counts", func(t *testing.T) {
+		_, diags := ListAll(context.Background(), "items", types.Int64Null(),
+			func(_ context.Context, _, pageSize int64) ([]int, int64, error) {
+				return make([]int, pageSize), 1 << 40, nil
+			})
+		if !diags.HasError() {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	items := []string{"a", "b", "c"}
+
+	got, diags := Truncate("items", types.Int64Value(2), items)
+	if len(got) != 2 || diags.WarningsCount() != 1 {
+		t.Errorf("got %v, %v", got, diags)
+	}
+	want := diag.NewWarningDiagnostic("Truncated items",
+		"Returning the first 2 of 3 items. Raise or remove max_items to read them all.")
+	if !diags[0].Equal(want) {
+		t.Errorf("got %v, want %v", diags[0], want)
+	}
+
+	for _, maxItems := range []types.Int64{types.Int64Null(), types.Int64Value(3)} {
+		if got, diags := Truncate("items", maxItems, items); len(got) != 3 || len(diags) != 0 {
+			t.Errorf("max_items %s: got %v, %v", maxItems, got, diags)
+		}
+	}
+}
+
+func TestTruncateTotal(t *testing.T) {
+	items := []string{"a", "b"}
+
+	got, diags := TruncateTotal("items", types.Int64Value(2), items, 5)
+	if len(got) != 2 || diags.WarningsCount() != 1 {
+		t.Errorf("got %v, %v", got, diags)
+	}
+	want := diag.NewWarningDiagnostic("Truncated items",
+		"Returning the first 2 of 5 items. Raise or remove max_items to read them all.")
+	if !diags[0].Equal(want) {
+		t.Errorf("got %v, want %v", diags[0], want)
+	}
+
+	for _, maxItems := range []types.Int64{types.Int64Null(), types.Int64Value(2)} {
+		if got, diags := TruncateTotal("items", maxItems, items, 2); len(got) != 2 || len(diags) != 0 {
+			t.Errorf("max_items %s: got %v, %v", maxItems, got, diags)
+		}
+	}
+}
+
+func TestListEach(t *testing.T) {
+	all := make([]int, 250)
+	for i := range all {
+		all[i] = i
+	}
+	var calls int
+	fetch := func(_ context.Context, page, pageSize int64) ([]int, int64, error) {
+		calls++
+		start := min((page-1)*pageSize, int64(len(all)))
+		end := min(start+pageSize, int64(len(all)))
+		return all[start:end], int64(len(all)), nil
+	}
+
+	var got []int
+	err := ListEach(context.Background(), 100, fetch, func(i int) bool {
+		got = append(got, i)
+		return true
+	})
+	if err != nil || len(got) != 250 || calls != 3 {
+		t.Errorf("got %d items in %d calls, %v", len(got), calls, err)
+	}
+
+	calls, got = 0, nil
+	err = ListEach(context.Background(), 100, fetch, func(i int) bool {
+		got = append(got, i)
+		return len(got) < 120
+	})
+	if err != nil || len(got) != 120 || calls != 2 {
+		t.Errorf("stopping early: got %d items in %d calls, %v", len(got), calls, err)
+	}
+
+	err = ListEach(context.Background(), 100,
+		func(context.Context, int64, int64) ([]int, int64, error) { return nil, 0, errors.New("boom") },
+		func(int) bool { return true })
+	if err == nil || err.Error() != "boom" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
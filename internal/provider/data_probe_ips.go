@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func NewProbeIPsDataSource(_ context.Context, p *providerImpl) datasource.DataSource {
+	return ProbeIPsDataSource{p: p}
+}
+
+// ProbeIPsDataSchema defines the schema for the probe IPs data source.
+var ProbeIPsDataSchema = schema.Schema{
+	Description: "Retrieve the deduplicated IPv4 and IPv6 addresses of the probe servers for a set of locations, " +
+		"as plain addresses and collapsed into minimal CIDR blocks, for use in firewall allowlists. " +
+		"Private locations are probe servers of the account and are handled like public ones. " +
+		"When neither `locations` nor `check_ids` is set, all locations are included, private ones too.",
+	Attributes: map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "Placeholder identifier for the data source",
+		},
+		"locations": schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "Locations to include, as used in the `locations` attribute of checks, public or private",
+		},
+		"check_ids": schema.SetAttribute{
+			Optional:    true,
+			ElementType: types.Int64Type,
+			Description: "Include every location used by these checks",
+		},
+		"ipv4_addresses": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Sorted, deduplicated IPv4 addresses",
+		},
+		"ipv6_addresses": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "Sorted, deduplicated IPv6 addresses",
+		},
+		"ipv4_cidrs": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "IPv4 addresses collapsed into the minimal list of CIDR blocks covering exactly those addresses",
+		},
+		"ipv6_cidrs": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "IPv6 addresses collapsed into the minimal list of CIDR blocks covering exactly those addresses",
+		},
+	},
+}
+
+type ProbeIPsDataSourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Locations     []types.String `tfsdk:"locations"`
+	CheckIDs      []types.Int64  `tfsdk:"check_ids"`
+	IPv4Addresses []types.String `tfsdk:"ipv4_addresses"`
+	IPv6Addresses []types.String `tfsdk:"ipv6_addresses"`
+	IPv4CIDRs     []types.String `tfsdk:"ipv4_cidrs"`
+	IPv6CIDRs     []types.String `tfsdk:"ipv6_cidrs"`
+}
+
+var _ datasource.DataSource = &ProbeIPsDataSource{}
+
+type ProbeIPsDataSource struct {
+	p *providerImpl
+}
+
+func (d ProbeIPsDataSource) Metadata(_ context.Context, rq datasource.MetadataRequest, rs *datasource.MetadataResponse) {
+	rs.TypeName = rq.ProviderTypeName + "_probe_ips"
+}
+
+func (d ProbeIPsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, rs *datasource.SchemaResponse) {
+	rs.Schema = ProbeIPsDataSchema
+}
+
+func (d ProbeIPsDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config ProbeIPsDataSourceModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := d.p.api.ProbeServers().List(ctx)
+	if err != nil {
+		rs.Diagnostics.AddError("API call failed", err.Error())
+		return
+	}
+	known := make(map[string]struct{}, len(api.Items))
+	for _, server := range api.Items {
+		known[server.Location] = struct{}{}
+	}
+
+	// nil selects every location
+	var selected map[string]struct{}
+	if config.Locations != nil || config.CheckIDs != nil {
+		selected = make(map[string]struct{})
+	}
+	for _, v := range config.Locations {
+		name := v.ValueString()
+		if _, ok := known[name]; !ok {
+			detail := fmt.Sprintf("Location %q does not exist.", name)
+			if s := closestName(name, known); s != "" {
+				detail += fmt.Sprintf(" Did you mean %q?", s)
+			}
+			rs.Diagnostics.AddAttributeError(path.Root("locations"), "Location not found", detail)
+			continue
+		}
+		selected[name] = struct{}{}
+	}
+	for _, v := range config.CheckIDs {
+		check, err := d.p.api.Checks().Get(ctx, upapi.PrimaryKey(v.ValueInt64()))
+		if err != nil {
+			rs.Diagnostics.AddError("API call failed", fmt.Sprintf("check %d: %s", v.ValueInt64(), err.Error()))
+			return
+		}
+		for _, name := range check.Locations {
+			if _, ok := known[name]; !ok {
+				rs.Diagnostics.AddAttributeError(path.Root("check_ids"), "Location not found",
+					fmt.Sprintf("Check %d uses location %q, which has no probe servers.", v.ValueInt64(), name))
+				continue
+			}
+			selected[name] = struct{}{}
+		}
+	}
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	var addrs []string
+	for _, server := range api.Items {
+		if _, ok := selected[server.Location]; selected != nil && !ok {
+			continue
+		}
+		addrs = append(addrs, server.IPv4Addresses...)
+		addrs = append(addrs, server.IPv6Addresses...)
+	}
+	v4, v6, err := probeAddresses(addrs)
+	if err != nil {
+		rs.Diagnostics.AddError("Invalid probe server address", err.Error())
+		return
+	}
+
+	model := ProbeIPsDataSourceModel{
+		ID:            types.StringValue(""),
+		Locations:     config.Locations,
+		CheckIDs:      config.CheckIDs,
+		IPv4Addresses: prefixStrings(v4, true),
+		IPv6Addresses: prefixStrings(v6, true),
+		IPv4CIDRs:     prefixStrings(collapsePrefixes(v4), false),
+		IPv6CIDRs:     prefixStrings(collapsePrefixes(v6), false),
+	}
+	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
+}
+
+// probeAddresses parses, deduplicates and sorts the given addresses, splitting
+// them by family. Entries may be plain addresses or CIDR blocks.
+func probeAddresses(in []string) (v4, v6 []netip.Prefix, err error) {
+	seen := make(map[netip.Prefix]struct{}, len(in))
+	for _, s := range in {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		var p netip.Prefix
+		if strings.Contains(s, "/") {
+			p, err = netip.ParsePrefix(s)
+			if err != nil {
+				return nil, nil, err
+			}
+			p = netip.PrefixFrom(p.Addr().Unmap(), p.Bits()).Masked()
+		} else {
+			a, err := netip.ParseAddr(s)
+			if err != nil {
+				return nil, nil, err
+			}
+			a = a.Unmap().WithZone("")
+			p = netip.PrefixFrom(a, a.BitLen())
+		}
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		if p.Addr().Is4() {
+			v4 = append(v4, p)
+		} else {
+			v6 = append(v6, p)
+		}
+	}
+	sortPrefixes(v4)
+	sortPrefixes(v6)
+	return v4, v6, nil
+}
+
+func sortPrefixes(s []netip.Prefix) {
+	sort.Slice(s, func(i, j int) bool {
+		if c := s[i].Addr().Compare(s[j].Addr()); c != 0 {
+			return c < 0
+		}
+		return s[i].Bits() < s[j].Bits()
+	})
+}
+
+// collapsePrefixes returns the minimal list of prefixes covering exactly the
+// same addresses as the sorted input.
+func collapsePrefixes(in []netip.Prefix) []netip.Prefix {
+	var out []netip.Prefix
+	for _, p := range in {
+		// sorted input: a covering prefix always comes first
+		if n := len(out); n > 0 && out[n-1].Contains(p.Addr()) && out[n-1].Bits() <= p.Bits() {
+			continue
+		}
+		out = append(out, p)
+		for n := len(out); n >= 2; n = len(out) {
+			a, b := out[n-2], out[n-1]
+			if a.Bits() != b.Bits() || a.Bits() == 0 {
+				break
+			}
+			parent, _ := a.Addr().Prefix(a.Bits() - 1)
+			if !parent.Contains(b.Addr()) || a.Addr() == b.Addr() {
+				break
+			}
+			out = append(out[:n-2], parent)
+		}
+	}
+	return out
+}
+
+// prefixStrings formats prefixes, writing single addresses without a prefix
+// length.
+func prefixStrings(in []netip.Prefix, bare bool) []types.String {
+	out := make([]types.String, len(in))
+	for i, p := range in {
+		if bare && p.IsSingleIP() {
+			out[i] = types.StringValue(p.Addr().String())
+		} else {
+			out[i] = types.StringValue(p.String())
+		}
+	}
+	return out
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProbeIPsDataSource(t *testing.T) {
+	resource.Test(t, testCaseFromSteps(t, []resource.TestStep{
+		{
+			Config: `
+data "uptime_probe_ips" "all" {}
+
+data "uptime_probe_ips" "eu" {
+  locations = ["United Kingdom-London", "Netherlands-Amsterdam"]
+}
+`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet("data.uptime_probe_ips.all", "ipv4_addresses.0"),
+				resource.TestCheckResourceAttrSet("data.uptime_probe_ips.all", "ipv4_cidrs.0"),
+				resource.TestCheckResourceAttrSet("data.uptime_probe_ips.eu", "ipv4_addresses.0"),
+			),
+		},
+	}))
+}
+
+func TestProbeAddresses(t *testing.T) {
+	v4, v6, err := probeAddresses([]string{
+		"10.0.0.2", " 10.0.0.1", "10.0.0.2", "::ffff:10.0.0.3", "", "2001:db8::1", "2001:DB8::1", "192.0.2.0/24",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := prefixStrings(v4, true), prefixStrings([]netip.Prefix{
+		netip.MustParsePrefix("10.0.0.1/32"),
+		netip.MustParsePrefix("10.0.0.2/32"),
+		netip.MustParsePrefix("10.0.0.3/32"),
+		netip.MustParsePrefix("192.0.2.0/24"),
+	}, true); !reflect.DeepEqual(got, want) {
+		t.Errorf("got IPv4 %v, want %v", got, want)
+	}
+	if got, want := prefixStrings(v6, true), prefixStrings([]netip.Prefix{
+		netip.MustParsePrefix("2001:db8::1/128"),
+	}, true); !reflect.DeepEqual(got, want) {
+		t.Errorf("got IPv6 %v, want %v", got, want)
+	}
+
+	if _, _, err := probeAddresses([]string{"10.0.0.256"}); err == nil {
+		t.Error("expected an error for an invalid address")
+	}
+}
+
+func TestCollapsePrefixes(t *testing.T) {
+	cases := []struct {
+		name string
+		in   []string
+		want []string
+	}{
+		{
+			name: "merges adjacent addresses",
+			in:   []string{"10.0.0.0", "10.0.0.1", "10.0.0.2", "10.0.0.3"},
+			want: []string{"10.0.0.0/30"},
+		},
+		{
+			name: "merges across levels",
+			in:   []string{"10.0.0.0/31", "10.0.0.2", "10.0.0.3", "10.0.0.5"},
+			want: []string{"10.0.0.0/30", "10.0.0.5/32"},
+		},
+		{
+			name: "does not merge unaligned neighbours",
+			in:   []string{"10.0.0.1", "10.0.0.2"},
+			want: []string{"10.0.0.1/32", "10.0.0.2/32"},
+		},
+		{
+			name: "drops covered prefixes",
+			in:   []string{"10.0.0.7", "10.0.0.0/29", "10.0.0.8"},
+			want: []string{"10.0.0.0/29", "10.0.0.8/32"},
+		},
+		{
+			name: "handles IPv6",
+			in:   []string{"2001:db8::", "2001:db8::1"},
+			want: []string{"2001:db8::/127"},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			v4, v6, err := probeAddresses(c.in)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := prefixStrings(collapsePrefixes(append(v4, v6...)), false)
+			want := make([]string, len(got))
+			for i := range got {
+				want[i] = got[i].ValueString()
+			}
+			if !reflect.DeepEqual(want, c.want) {
+				t.Errorf("got %v, want %v", want, c.want)
+			}
+		})
+	}
+}
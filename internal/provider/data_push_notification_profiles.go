@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -22,6 +23,7 @@ var PushNotificationProfilesDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"push_notification_profiles": schema.ListNestedAttribute{
 			Computed:    true,
 			Description: "List of all push notification profiles in the account",
@@ -72,6 +74,7 @@ var PushNotificationProfilesDataSchema = schema.Schema{
 
 type PushNotificationProfilesDataSourceModel struct {
 	ID                       types.String                                  `tfsdk:"id"`
+	MaxItems                 types.Int64                                   `tfsdk:"max_items"`
 	PushNotificationProfiles []PushNotificationProfilesDataSourceItemModel `tfsdk:"push_notification_profiles"`
 }
 
@@ -101,41 +104,68 @@ func (d PushNotificationProfilesDataSource) Schema(_ context.Context, _ datasour
 	rs.Schema = PushNotificationProfilesDataSchema
 }
 
-func (d PushNotificationProfilesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, rs *datasource.ReadResponse) {
-	api, err := d.p.api.PushNotifications().List(ctx, upapi.PushNotificationProfileListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+func (d PushNotificationProfilesDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config PushNotificationProfilesDataSourceModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
-	model := PushNotificationProfilesDataSourceModel{
-		ID:                       types.StringValue(""),
-		PushNotificationProfiles: make([]PushNotificationProfilesDataSourceItemModel, len(api.Items)),
-	}
-
-	for i := range api.Items {
-		// Convert ContactGroups slice to types.List
-		contactGroupsValues := make([]attr.Value, len(api.Items[i].ContactGroups))
-		for j, v := range api.Items[i].ContactGroups {
-			contactGroupsValues[j] = types.StringValue(v)
+	limit := listLimit(config.MaxItems)
+	profiles := make([]PushNotificationProfilesDataSourceItemModel, 0)
+	var total int64
+	for page := int64(1); ; page++ {
+		if page > listMaxPages {
+			rs.Diagnostics.AddError("API call failed",
+				fmt.Sprintf("Listing push notification profiles paged past %d pages without reaching the end. "+
+					"The server may be returning inconsistent counts.", listMaxPages))
+			return
 		}
-		contactGroups := types.ListNull(types.StringType)
-		if len(contactGroupsValues) > 0 {
-			contactGroups = types.ListValueMust(types.StringType, contactGroupsValues)
+		api, err := d.p.api.PushNotifications().List(ctx, upapi.PushNotificationProfileListOptions{
+			Page:     page,
+			PageSize: listPageSize,
+		})
+		if err != nil {
+			rs.Diagnostics.AddError("API call failed", err.Error())
+			return
 		}
+		total = api.TotalCount
+		for i := range api.Items {
+			// Convert ContactGroups slice to types.List
+			contactGroupsValues := make([]attr.Value, len(api.Items[i].ContactGroups))
+			for j, v := range api.Items[i].ContactGroups {
+				contactGroupsValues[j] = types.StringValue(v)
+			}
+			contactGroups := types.ListNull(types.StringType)
+			if len(contactGroupsValues) > 0 {
+				contactGroups = types.ListValueMust(types.StringType, contactGroupsValues)
+			}
 
-		model.PushNotificationProfiles[i] = PushNotificationProfilesDataSourceItemModel{
-			ID:            types.Int64Value(api.Items[i].PK),
-			URL:           types.StringValue(api.Items[i].URL),
-			CreatedAt:     types.StringValue(api.Items[i].CreatedAt),
-			ModifiedAt:    types.StringValue(api.Items[i].ModifiedAt),
-			UUID:          types.StringValue(api.Items[i].UUID),
-			User:          types.StringValue(api.Items[i].User),
-			DeviceName:    types.StringValue(api.Items[i].DeviceName),
-			DisplayName:   types.StringValue(api.Items[i].DisplayName),
-			ContactGroups: contactGroups,
+			profiles = append(profiles, PushNotificationProfilesDataSourceItemModel{
+				ID:            types.Int64Value(api.Items[i].PK),
+				URL:           types.StringValue(api.Items[i].URL),
+				CreatedAt:     types.StringValue(api.Items[i].CreatedAt),
+				ModifiedAt:    types.StringValue(api.Items[i].ModifiedAt),
+				UUID:          types.StringValue(api.Items[i].UUID),
+				User:          types.StringValue(api.Items[i].User),
+				DeviceName:    types.StringValue(api.Items[i].DeviceName),
+				DisplayName:   types.StringValue(api.Items[i].DisplayName),
+				ContactGroups: contactGroups,
+			})
+		}
+		read := int64(len(profiles))
+		if int64(len(api.Items)) < listPageSize || read >= api.TotalCount || (limit >= 0 && read >= limit) {
+			break
 		}
 	}
+	profiles, diags := TruncateTotal("push notification profiles", config.MaxItems, profiles, total)
+	rs.Diagnostics.Append(diags...)
+
+	model := PushNotificationProfilesDataSourceModel{
+		ID:                       types.StringValue(""),
+		MaxItems:                 config.MaxItems,
+		PushNotificationProfiles: profiles,
+	}
 
 	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
 }
@@ -22,6 +22,7 @@ var ScheduledReportsDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"scheduled_reports": schema.ListNestedAttribute{
 			Computed:    true,
 			Description: "List of all scheduled reports in the account",
@@ -81,6 +82,7 @@ var ScheduledReportsDataSchema = schema.Schema{
 
 type ScheduledReportsDataSourceModel struct {
 	ID               types.String                          `tfsdk:"id"`
+	MaxItems         types.Int64                           `tfsdk:"max_items"`
 	ScheduledReports []ScheduledReportsDataSourceItemModel `tfsdk:"scheduled_reports"`
 }
 
@@ -112,22 +114,35 @@ func (d ScheduledReportsDataSource) Schema(_ context.Context, _ datasource.Schem
 	rs.Schema = ScheduledReportsDataSchema
 }
 
-func (d ScheduledReportsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, rs *datasource.ReadResponse) {
-	api, err := d.p.api.ScheduledReports().List(ctx, upapi.ScheduledReportListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+func (d ScheduledReportsDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config ScheduledReportsDataSourceModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := ListAll(ctx, "scheduled reports", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.ScheduledReport, int64, error) {
+		api, err := d.p.api.ScheduledReports().List(ctx, upapi.ScheduledReportListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
 	model := ScheduledReportsDataSourceModel{
 		ID:               types.StringValue(""),
-		ScheduledReports: make([]ScheduledReportsDataSourceItemModel, len(api.Items)),
+		MaxItems:         config.MaxItems,
+		ScheduledReports: make([]ScheduledReportsDataSourceItemModel, len(items)),
 	}
 
-	for i := range api.Items {
+	for i := range items {
 		// Convert RecipientUsers slice to types.List
-		recipientUsersValues := make([]attr.Value, len(api.Items[i].RecipientUsers))
-		for j, v := range api.Items[i].RecipientUsers {
+		recipientUsersValues := make([]attr.Value, len(items[i].RecipientUsers))
+		for j, v := range items[i].RecipientUsers {
 			recipientUsersValues[j] = types.StringValue(v)
 		}
 		recipientUsers := types.ListNull(types.StringType)
@@ -136,8 +151,8 @@ func (d ScheduledReportsDataSource) Read(ctx context.Context, _ datasource.ReadR
 		}
 
 		// Convert RecipientEmails slice to types.List
-		recipientEmailsValues := make([]attr.Value, len(api.Items[i].RecipientEmails))
-		for j, v := range api.Items[i].RecipientEmails {
+		recipientEmailsValues := make([]attr.Value, len(items[i].RecipientEmails))
+		for j, v := range items[i].RecipientEmails {
 			recipientEmailsValues[j] = types.StringValue(v)
 		}
 		recipientEmails := types.ListNull(types.StringType)
@@ -146,17 +161,17 @@ func (d ScheduledReportsDataSource) Read(ctx context.Context, _ datasource.ReadR
 		}
 
 		model.ScheduledReports[i] = ScheduledReportsDataSourceItemModel{
-			ID:              types.Int64Value(api.Items[i].PK),
-			URL:             types.StringValue(api.Items[i].URL),
-			Name:            types.StringValue(api.Items[i].Name),
-			SLAReport:       types.StringValue(api.Items[i].ScheduledReport),
+			ID:              types.Int64Value(items[i].PK),
+			URL:             types.StringValue(items[i].URL),
+			Name:            types.StringValue(items[i].Name),
+			SLAReport:       types.StringValue(items[i].ScheduledReport),
 			RecipientUsers:  recipientUsers,
 			RecipientEmails: recipientEmails,
-			FileType:        types.StringValue(api.Items[i].FileType),
-			Recurrence:      types.StringValue(api.Items[i].Recurrence),
-			OnWeekday:       types.Int64Value(int64(api.Items[i].OnWeekday)),
-			AtTime:          types.Int64Value(int64(api.Items[i].AtTime)),
-			IsEnabled:       types.BoolValue(api.Items[i].IsEnabled),
+			FileType:        types.StringValue(items[i].FileType),
+			Recurrence:      types.StringValue(items[i].Recurrence),
+			OnWeekday:       types.Int64Value(int64(items[i].OnWeekday)),
+			AtTime:          types.Int64Value(int64(items[i].AtTime)),
+			IsEnabled:       types.BoolValue(items[i].IsEnabled),
 		}
 	}
 
@@ -22,6 +22,7 @@ var SLAReportsDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"sla_reports": schema.ListNestedAttribute{
 			Computed:    true,
 			Description: "List of all SLA reports in the account",
@@ -88,6 +89,7 @@ var SLAReportsDataSchema = schema.Schema{
 
 type SLAReportsDataSourceModel struct {
 	ID         types.String                    `tfsdk:"id"`
+	MaxItems   types.Int64                     `tfsdk:"max_items"`
 	SLAReports []SLAReportsDataSourceItemModel `tfsdk:"sla_reports"`
 }
 
@@ -121,22 +123,35 @@ func (d SLAReportsDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 	rs.Schema = SLAReportsDataSchema
 }
 
-func (d SLAReportsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, rs *datasource.ReadResponse) {
-	api, err := d.p.api.SLAReports().List(ctx, upapi.SLAReportListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+func (d SLAReportsDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config SLAReportsDataSourceModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := ListAll(ctx, "SLA reports", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.SLAReport, int64, error) {
+		api, err := d.p.api.SLAReports().List(ctx, upapi.SLAReportListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
 	model := SLAReportsDataSourceModel{
 		ID:         types.StringValue(""),
-		SLAReports: make([]SLAReportsDataSourceItemModel, len(api.Items)),
+		MaxItems:   config.MaxItems,
+		SLAReports: make([]SLAReportsDataSourceItemModel, len(items)),
 	}
 
-	for i := range api.Items {
+	for i := range items {
 		// Convert ServicesTags slice to types.List
-		servicesTagsValues := make([]attr.Value, len(api.Items[i].ServicesTags))
-		for j, v := range api.Items[i].ServicesTags {
+		servicesTagsValues := make([]attr.Value, len(items[i].ServicesTags))
+		for j, v := range items[i].ServicesTags {
 			servicesTagsValues[j] = types.StringValue(v)
 		}
 		servicesTags := types.ListNull(types.StringType)
@@ -145,19 +160,19 @@ func (d SLAReportsDataSource) Read(ctx context.Context, _ datasource.ReadRequest
 		}
 
 		model.SLAReports[i] = SLAReportsDataSourceItemModel{
-			ID:                              types.Int64Value(api.Items[i].PK),
-			URL:                             types.StringValue(api.Items[i].URL),
-			StatsURL:                        types.StringValue(api.Items[i].StatsURL),
-			Name:                            types.StringValue(api.Items[i].Name),
+			ID:                              types.Int64Value(items[i].PK),
+			URL:                             types.StringValue(items[i].URL),
+			StatsURL:                        types.StringValue(items[i].StatsURL),
+			Name:                            types.StringValue(items[i].Name),
 			ServicesTags:                    servicesTags,
-			DefaultDateRange:                types.StringValue(api.Items[i].DefaultDateRange),
-			FilterWithDowntime:              types.BoolValue(api.Items[i].FilterWithDowntime),
-			FilterUptimeSLAViolations:       types.BoolValue(api.Items[i].FilterUptimeSLAViolations),
-			FilterSlowest:                   types.BoolValue(api.Items[i].FilterSlowest),
-			FilterResponseTimeSLAViolations: types.BoolValue(api.Items[i].FilterResponseTimeSLAViolations),
-			ShowUptimeSection:               types.BoolValue(api.Items[i].ShowUptimeSection),
-			ShowUptimeSLA:                   types.BoolValue(api.Items[i].ShowUptimeSLA),
-			ShowResponseTimeSection:         types.BoolValue(api.Items[i].ShowResponseTimeSection),
+			DefaultDateRange:                types.StringValue(items[i].DefaultDateRange),
+			FilterWithDowntime:              types.BoolValue(items[i].FilterWithDowntime),
+			FilterUptimeSLAViolations:       types.BoolValue(items[i].FilterUptimeSLAViolations),
+			FilterSlowest:                   types.BoolValue(items[i].FilterSlowest),
+			FilterResponseTimeSLAViolations: types.BoolValue(items[i].FilterResponseTimeSLAViolations),
+			ShowUptimeSection:               types.BoolValue(items[i].ShowUptimeSection),
+			ShowUptimeSLA:                   types.BoolValue(items[i].ShowUptimeSLA),
+			ShowResponseTimeSection:         types.BoolValue(items[i].ShowResponseTimeSection),
 		}
 	}
 
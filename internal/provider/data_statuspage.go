@@ -20,6 +20,7 @@ var StatusPageDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"statuspages": schema.ListNestedAttribute{
 			Computed:    true,
 			Description: "List of all status pages in the account",
@@ -73,6 +74,7 @@ var StatusPageDataSchema = schema.Schema{
 
 type StatusPageDataSourceModel struct {
 	ID          types.String                    `tfsdk:"id"`
+	MaxItems    types.Int64                     `tfsdk:"max_items"`
 	StatusPages []StatusPageDataSourceItemModel `tfsdk:"statuspages"`
 }
 
@@ -103,32 +105,49 @@ func (d StatusPageDataSource) Schema(_ context.Context, _ datasource.SchemaReque
 	rs.Schema = StatusPageDataSchema
 }
 
-func (d StatusPageDataSource) Read(ctx context.Context, _ datasource.ReadRequest, rs *datasource.ReadResponse) {
-	api, err := d.p.api.StatusPages().List(ctx, upapi.StatusPageListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+func (d StatusPageDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config StatusPageDataSourceModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := ListAll(ctx, "status pages", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.StatusPage, int64, error) {
+		api, err := d.p.api.StatusPages().List(ctx, upapi.StatusPageListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
 	model := StatusPageDataSourceModel{
 		ID:          types.StringValue(""),
-		StatusPages: make([]StatusPageDataSourceItemModel, len(api.Items)),
+		MaxItems:    config.MaxItems,
+		StatusPages: make([]StatusPageDataSourceItemModel, len(items)),
 	}
 
-	for i := range api.Items {
-		model.StatusPages[i] = StatusPageDataSourceItemModel{
-			ID:              types.Int64Value(api.Items[i].PK),
-			URL:             types.StringValue(api.Items[i].URL),
-			Name:            types.StringValue(api.Items[i].Name),
-			VisibilityLevel: types.StringValue(api.Items[i].VisibilityLevel),
-			Description:     types.StringValue(api.Items[i].Description),
-			PageType:        types.StringValue(api.Items[i].PageType),
-			Slug:            types.StringValue(api.Items[i].Slug),
-			CNAME:           types.StringValue(api.Items[i].CNAME),
-			Timezone:        types.StringValue(api.Items[i].Timezone),
-			Theme:           types.StringValue(api.Items[i].Theme),
-		}
+	for i := range items {
+		model.StatusPages[i] = statusPageItemModel(items[i])
 	}
 
-	rs.Diagnostics = rs.State.Set(ctx, model)
+	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
+}
+
+func statusPageItemModel(obj upapi.StatusPage) StatusPageDataSourceItemModel {
+	return StatusPageDataSourceItemModel{
+		ID:              types.Int64Value(obj.PK),
+		URL:             types.StringValue(obj.URL),
+		Name:            types.StringValue(obj.Name),
+		VisibilityLevel: types.StringValue(obj.VisibilityLevel),
+		Description:     types.StringValue(obj.Description),
+		PageType:        types.StringValue(obj.PageType),
+		Slug:            types.StringValue(obj.Slug),
+		CNAME:           types.StringValue(obj.CNAME),
+		Timezone:        types.StringValue(obj.Timezone),
+		Theme:           types.StringValue(obj.Theme),
+	}
 }
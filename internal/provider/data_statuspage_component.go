@@ -20,6 +20,7 @@ var StatusPageComponentDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"statuspage_id": schema.Int64Attribute{
 			Required:    true,
 			Description: "ID of the status page to retrieve components for",
@@ -81,6 +82,7 @@ var StatusPageComponentDataSchema = schema.Schema{
 
 type StatusPageComponentDataSourceModel struct {
 	ID           types.String                             `tfsdk:"id"`
+	MaxItems     types.Int64                              `tfsdk:"max_items"`
 	StatusPageID types.Int64                              `tfsdk:"statuspage_id"`
 	Components   []StatusPageComponentDataSourceItemModel `tfsdk:"components"`
 }
@@ -122,43 +124,50 @@ func (d StatusPageComponentDataSource) Read(ctx context.Context, rq datasource.R
 	}
 
 	pk := upapi.PrimaryKey(config.StatusPageID.ValueInt64())
-	api, err := d.p.api.StatusPages().Components(pk).List(ctx, upapi.StatusPageComponentListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+	items, diags := ListAll(ctx, "components", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.StatusPageComponent, int64, error) {
+		api, err := d.p.api.StatusPages().Components(pk).List(ctx, upapi.StatusPageComponentListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
 	model := StatusPageComponentDataSourceModel{
 		ID:           types.StringValue(""),
+		MaxItems:     config.MaxItems,
 		StatusPageID: config.StatusPageID,
-		Components:   make([]StatusPageComponentDataSourceItemModel, len(api.Items)),
+		Components:   make([]StatusPageComponentDataSourceItemModel, len(items)),
 	}
 
-	for i := range api.Items {
+	for i := range items {
 		groupID := types.Int64Null()
-		if api.Items[i].GroupID != nil {
-			groupID = types.Int64Value(*api.Items[i].GroupID)
+		if items[i].GroupID != nil {
+			groupID = types.Int64Value(*items[i].GroupID)
 		}
 
 		serviceID := types.Int64Null()
-		if api.Items[i].ServiceID != nil {
-			serviceID = types.Int64Value(*api.Items[i].ServiceID)
+		if items[i].ServiceID != nil {
+			serviceID = types.Int64Value(*items[i].ServiceID)
 		}
 
 		model.Components[i] = StatusPageComponentDataSourceItemModel{
-			ID:             types.Int64Value(api.Items[i].PK),
-			URL:            types.StringValue(api.Items[i].URL),
-			Name:           types.StringValue(api.Items[i].Name),
-			Description:    types.StringValue(api.Items[i].Description),
-			IsGroup:        types.BoolValue(api.Items[i].IsGroup),
+			ID:             types.Int64Value(items[i].PK),
+			URL:            types.StringValue(items[i].URL),
+			Name:           types.StringValue(items[i].Name),
+			Description:    types.StringValue(items[i].Description),
+			IsGroup:        types.BoolValue(items[i].IsGroup),
 			GroupID:        groupID,
 			ServiceID:      serviceID,
-			Status:         types.StringValue(api.Items[i].Status),
-			AutoStatusDown: types.StringValue(api.Items[i].AutoStatusDown),
-			AutoStatusUp:   types.StringValue(api.Items[i].AutoStatusUp),
-			SortingWeight:  types.Int64PointerValue(api.Items[i].SortingWeight),
+			Status:         types.StringValue(items[i].Status),
+			AutoStatusDown: types.StringValue(items[i].AutoStatusDown),
+			AutoStatusUp:   types.StringValue(items[i].AutoStatusUp),
+			SortingWeight:  types.Int64PointerValue(items[i].SortingWeight),
 		}
 	}
 
-	rs.Diagnostics = rs.State.Set(ctx, model)
+	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
 }
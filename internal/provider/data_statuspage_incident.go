@@ -20,6 +20,7 @@ var StatusPageIncidentDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"statuspage_id": schema.Int64Attribute{
 			Required:    true,
 			Description: "ID of the status page to retrieve incidents for",
@@ -65,6 +66,7 @@ var StatusPageIncidentDataSchema = schema.Schema{
 
 type StatusPageIncidentDataSourceModel struct {
 	ID           types.String                            `tfsdk:"id"`
+	MaxItems     types.Int64                             `tfsdk:"max_items"`
 	StatusPageID types.Int64                             `tfsdk:"statuspage_id"`
 	Incidents    []StatusPageIncidentDataSourceItemModel `tfsdk:"incidents"`
 }
@@ -102,29 +104,36 @@ func (d StatusPageIncidentDataSource) Read(ctx context.Context, rq datasource.Re
 	}
 
 	pk := upapi.PrimaryKey(config.StatusPageID.ValueInt64())
-	api, err := d.p.api.StatusPages().Incidents(pk).List(ctx, upapi.StatusPageIncidentListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+	items, diags := ListAll(ctx, "incidents", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.StatusPageIncident, int64, error) {
+		api, err := d.p.api.StatusPages().Incidents(pk).List(ctx, upapi.StatusPageIncidentListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
 	model := StatusPageIncidentDataSourceModel{
 		ID:           types.StringValue(""),
+		MaxItems:     config.MaxItems,
 		StatusPageID: config.StatusPageID,
-		Incidents:    make([]StatusPageIncidentDataSourceItemModel, len(api.Items)),
+		Incidents:    make([]StatusPageIncidentDataSourceItemModel, len(items)),
 	}
 
-	for i := range api.Items {
+	for i := range items {
 		model.Incidents[i] = StatusPageIncidentDataSourceItemModel{
-			ID:                     types.Int64Value(api.Items[i].PK),
-			URL:                    types.StringValue(api.Items[i].URL),
-			Name:                   types.StringValue(api.Items[i].Name),
-			IncidentType:           types.StringValue(api.Items[i].IncidentType),
-			StartsAt:               types.StringValue(api.Items[i].StartsAt),
-			EndsAt:                 types.StringValue(api.Items[i].EndsAt),
-			IncludeInGlobalMetrics: types.BoolValue(api.Items[i].IncludeInGlobalMetrics),
+			ID:                     types.Int64Value(items[i].PK),
+			URL:                    types.StringValue(items[i].URL),
+			Name:                   types.StringValue(items[i].Name),
+			IncidentType:           types.StringValue(items[i].IncidentType),
+			StartsAt:               types.StringValue(items[i].StartsAt),
+			EndsAt:                 types.StringValue(items[i].EndsAt),
+			IncludeInGlobalMetrics: types.BoolValue(items[i].IncludeInGlobalMetrics),
 		}
 	}
 
-	rs.Diagnostics = rs.State.Set(ctx, model)
+	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
 }
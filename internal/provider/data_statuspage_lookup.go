@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func NewStatusPageLookupDataSource(_ context.Context, p *providerImpl) datasource.DataSource {
+	return StatusPageLookupDataSource{p: p}
+}
+
+// StatusPageLookupDataSchema defines the schema for the singular status page data source.
+var StatusPageLookupDataSchema = schema.Schema{
+	Description: "Look up a single status page by name, slug or ID.",
+	Attributes: LookupSchemaAttributes(
+		StatusPageDataSchema.Attributes["statuspages"].(schema.ListNestedAttribute).NestedObject.Attributes,
+		"id", "name", "slug",
+	),
+}
+
+var (
+	_ datasource.DataSource                     = &StatusPageLookupDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &StatusPageLookupDataSource{}
+)
+
+type StatusPageLookupDataSource struct {
+	p *providerImpl
+}
+
+func (d StatusPageLookupDataSource) Metadata(_ context.Context, rq datasource.MetadataRequest, rs *datasource.MetadataResponse) {
+	rs.TypeName = rq.ProviderTypeName + "_statuspage"
+}
+
+func (d StatusPageLookupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, rs *datasource.SchemaResponse) {
+	rs.Schema = StatusPageLookupDataSchema
+}
+
+func (d StatusPageLookupDataSource) ConfigValidators(context.Context) []datasource.ConfigValidator {
+	return LookupConfigValidators("id", "name", "slug")
+}
+
+func (d StatusPageLookupDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config StatusPageDataSourceItemModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	var obj *upapi.StatusPage
+	if !config.ID.IsNull() {
+		var err error
+		obj, err = d.p.api.StatusPages().Get(ctx, upapi.PrimaryKey(config.ID.ValueInt64()))
+		if err != nil {
+			rs.Diagnostics.AddError("API call failed", err.Error())
+			return
+		}
+	} else {
+		items, diags := ListAll(ctx, "status pages", types.Int64Null(), func(ctx context.Context, page, pageSize int64) ([]upapi.StatusPage, int64, error) {
+			api, err := d.p.api.StatusPages().List(ctx, upapi.StatusPageListOptions{Page: page, PageSize: pageSize})
+			if err != nil {
+				return nil, 0, err
+			}
+			return api.Items, api.TotalCount, nil
+		})
+		rs.Diagnostics.Append(diags...)
+		if rs.Diagnostics.HasError() {
+			return
+		}
+		key, value := "name", config.Name.ValueString()
+		match := func(sp upapi.StatusPage) bool { return sp.Name == value }
+		if !config.Slug.IsNull() {
+			key, value = "slug", config.Slug.ValueString()
+			match = func(sp upapi.StatusPage) bool { return sp.Slug == value }
+		}
+		found, diags := LookupOne("status page", key, value, items, match,
+			func(sp upapi.StatusPage) string { return fmt.Sprintf("ID %d", sp.PK) },
+		)
+		rs.Diagnostics.Append(diags...)
+		if rs.Diagnostics.HasError() {
+			return
+		}
+		obj = found
+	}
+
+	rs.Diagnostics.Append(rs.State.Set(ctx, statusPageItemModel(*obj))...)
+}
@@ -20,6 +20,7 @@ var StatusPageMetricDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"statuspage_id": schema.Int64Attribute{
 			Required:    true,
 			Description: "ID of the status page to retrieve metrics for",
@@ -57,6 +58,7 @@ var StatusPageMetricDataSchema = schema.Schema{
 
 type StatusPageMetricDataSourceModel struct {
 	ID           types.String                          `tfsdk:"id"`
+	MaxItems     types.Int64                           `tfsdk:"max_items"`
 	StatusPageID types.Int64                           `tfsdk:"statuspage_id"`
 	Metrics      []StatusPageMetricDataSourceItemModel `tfsdk:"metrics"`
 }
@@ -92,27 +94,34 @@ func (d StatusPageMetricDataSource) Read(ctx context.Context, rq datasource.Read
 	}
 
 	pk := upapi.PrimaryKey(config.StatusPageID.ValueInt64())
-	api, err := d.p.api.StatusPages().Metrics(pk).List(ctx, upapi.StatusPageMetricListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+	items, diags := ListAll(ctx, "metrics", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.StatusPageMetric, int64, error) {
+		api, err := d.p.api.StatusPages().Metrics(pk).List(ctx, upapi.StatusPageMetricListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
 	model := StatusPageMetricDataSourceModel{
 		ID:           types.StringValue(""),
+		MaxItems:     config.MaxItems,
 		StatusPageID: config.StatusPageID,
-		Metrics:      make([]StatusPageMetricDataSourceItemModel, len(api.Items)),
+		Metrics:      make([]StatusPageMetricDataSourceItemModel, len(items)),
 	}
 
-	for i := range api.Items {
+	for i := range items {
 		model.Metrics[i] = StatusPageMetricDataSourceItemModel{
-			ID:        types.Int64Value(api.Items[i].PK),
-			URL:       types.StringValue(api.Items[i].URL),
-			Name:      types.StringValue(api.Items[i].Name),
-			ServiceID: types.Int64Value(api.Items[i].ServiceID),
-			IsVisible: types.BoolValue(api.Items[i].IsVisible),
+			ID:        types.Int64Value(items[i].PK),
+			URL:       types.StringValue(items[i].URL),
+			Name:      types.StringValue(items[i].Name),
+			ServiceID: types.Int64Value(items[i].ServiceID),
+			IsVisible: types.BoolValue(items[i].IsVisible),
 		}
 	}
 
-	rs.Diagnostics = rs.State.Set(ctx, model)
+	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
 }
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -20,6 +21,7 @@ var StatusPageStatusHistoryDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"statuspage_id": schema.Int64Attribute{
 			Required:    true,
 			Description: "ID of the status page to retrieve status history for",
@@ -77,6 +79,7 @@ var StatusPageStatusHistoryDataSchema = schema.Schema{
 
 type StatusPageStatusHistoryDataSourceModel struct {
 	ID           types.String                                 `tfsdk:"id"`
+	MaxItems     types.Int64                                  `tfsdk:"max_items"`
 	StatusPageID types.Int64                                  `tfsdk:"statuspage_id"`
 	Status       types.String                                 `tfsdk:"status"`
 	ComponentID  types.Int64                                  `tfsdk:"component_id"`
@@ -132,36 +135,55 @@ func (d StatusPageStatusHistoryDataSource) Read(ctx context.Context, rq datasour
 		opts.DateTo = config.DateTo.ValueString()
 	}
 
-	api, err := d.p.api.StatusPages().StatusHistory(pk).List(ctx, opts)
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
-		return
+	limit := listLimit(config.MaxItems)
+	history := make([]StatusPageStatusHistoryDataSourceItemModel, 0)
+	var total int64
+	for page := int64(1); ; page++ {
+		if page > listMaxPages {
+			rs.Diagnostics.AddError("API call failed",
+				fmt.Sprintf("Listing status history entries paged past %d pages without reaching the end. "+
+					"The server may be returning inconsistent counts.", listMaxPages))
+			return
+		}
+		opts.Page, opts.PageSize = page, listPageSize
+		api, err := d.p.api.StatusPages().StatusHistory(pk).List(ctx, opts)
+		if err != nil {
+			rs.Diagnostics.AddError("API call failed", err.Error())
+			return
+		}
+		total = api.TotalCount
+		for i := range api.Items {
+			componentID := types.Int64Null()
+			if api.Items[i].ComponentPK != nil {
+				componentID = types.Int64Value(*api.Items[i].ComponentPK)
+			}
+
+			history = append(history, StatusPageStatusHistoryDataSourceItemModel{
+				ID:          types.Int64Value(api.Items[i].PK),
+				Status:      types.StringValue(api.Items[i].Status),
+				Description: types.StringValue(api.Items[i].Description),
+				CreatedAt:   types.StringValue(api.Items[i].CreatedAt),
+				UpdatedAt:   types.StringValue(api.Items[i].UpdatedAt),
+				ComponentID: componentID,
+			})
+		}
+		read := int64(len(history))
+		if int64(len(api.Items)) < listPageSize || read >= api.TotalCount || (limit >= 0 && read >= limit) {
+			break
+		}
 	}
+	history, diags = TruncateTotal("status history entries", config.MaxItems, history, total)
+	rs.Diagnostics.Append(diags...)
 
 	model := StatusPageStatusHistoryDataSourceModel{
 		ID:           types.StringValue(""),
+		MaxItems:     config.MaxItems,
 		StatusPageID: config.StatusPageID,
 		Status:       config.Status,
 		ComponentID:  config.ComponentID,
 		DateFrom:     config.DateFrom,
 		DateTo:       config.DateTo,
-		History:      make([]StatusPageStatusHistoryDataSourceItemModel, len(api.Items)),
-	}
-
-	for i := range api.Items {
-		componentID := types.Int64Null()
-		if api.Items[i].ComponentPK != nil {
-			componentID = types.Int64Value(*api.Items[i].ComponentPK)
-		}
-
-		model.History[i] = StatusPageStatusHistoryDataSourceItemModel{
-			ID:          types.Int64Value(api.Items[i].PK),
-			Status:      types.StringValue(api.Items[i].Status),
-			Description: types.StringValue(api.Items[i].Description),
-			CreatedAt:   types.StringValue(api.Items[i].CreatedAt),
-			UpdatedAt:   types.StringValue(api.Items[i].UpdatedAt),
-			ComponentID: componentID,
-		}
+		History:      history,
 	}
 
 	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
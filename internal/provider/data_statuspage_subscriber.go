@@ -20,6 +20,7 @@ var StatusPageSubscriberDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"statuspage_id": schema.Int64Attribute{
 			Required:    true,
 			Description: "ID of the status page to retrieve subscribers for",
@@ -53,6 +54,7 @@ var StatusPageSubscriberDataSchema = schema.Schema{
 
 type StatusPageSubscriberDataSourceModel struct {
 	ID           types.String                              `tfsdk:"id"`
+	MaxItems     types.Int64                               `tfsdk:"max_items"`
 	StatusPageID types.Int64                               `tfsdk:"statuspage_id"`
 	Subscribers  []StatusPageSubscriberDataSourceItemModel `tfsdk:"subscribers"`
 }
@@ -87,26 +89,33 @@ func (d StatusPageSubscriberDataSource) Read(ctx context.Context, rq datasource.
 	}
 
 	pk := upapi.PrimaryKey(config.StatusPageID.ValueInt64())
-	api, err := d.p.api.StatusPages().Subscribers(pk).List(ctx, upapi.StatusPageSubscriberListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+	items, diags := ListAll(ctx, "subscribers", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.StatusPageSubscriber, int64, error) {
+		api, err := d.p.api.StatusPages().Subscribers(pk).List(ctx, upapi.StatusPageSubscriberListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
 	model := StatusPageSubscriberDataSourceModel{
 		ID:           types.StringValue(""),
+		MaxItems:     config.MaxItems,
 		StatusPageID: config.StatusPageID,
-		Subscribers:  make([]StatusPageSubscriberDataSourceItemModel, len(api.Items)),
+		Subscribers:  make([]StatusPageSubscriberDataSourceItemModel, len(items)),
 	}
 
-	for i := range api.Items {
+	for i := range items {
 		model.Subscribers[i] = StatusPageSubscriberDataSourceItemModel{
-			ID:                 types.Int64Value(api.Items[i].PK),
-			Target:             types.StringValue(api.Items[i].Target),
-			Type:               types.StringValue(api.Items[i].Type),
-			ForceValidationSMS: types.BoolValue(api.Items[i].ForceValidationSMS),
+			ID:                 types.Int64Value(items[i].PK),
+			Target:             types.StringValue(items[i].Target),
+			Type:               types.StringValue(items[i].Type),
+			ForceValidationSMS: types.BoolValue(items[i].ForceValidationSMS),
 		}
 	}
 
-	rs.Diagnostics = rs.State.Set(ctx, model)
+	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
 }
@@ -20,6 +20,7 @@ var StatusPageUserDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"statuspage_id": schema.Int64Attribute{
 			Required:    true,
 			Description: "ID of the status page to retrieve users for",
@@ -57,6 +58,7 @@ var StatusPageUserDataSchema = schema.Schema{
 
 type StatusPageUserDataSourceModel struct {
 	ID           types.String                        `tfsdk:"id"`
+	MaxItems     types.Int64                         `tfsdk:"max_items"`
 	StatusPageID types.Int64                         `tfsdk:"statuspage_id"`
 	Users        []StatusPageUserDataSourceItemModel `tfsdk:"users"`
 }
@@ -92,27 +94,34 @@ func (d StatusPageUserDataSource) Read(ctx context.Context, rq datasource.ReadRe
 	}
 
 	pk := upapi.PrimaryKey(config.StatusPageID.ValueInt64())
-	api, err := d.p.api.StatusPages().Users(pk).List(ctx, upapi.StatusPageUserListOptions{})
-	if err != nil {
-		rs.Diagnostics.AddError("API call failed", err.Error())
+	items, diags := ListAll(ctx, "users", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.StatusPageUser, int64, error) {
+		api, err := d.p.api.StatusPages().Users(pk).List(ctx, upapi.StatusPageUserListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
 		return
 	}
 
 	model := StatusPageUserDataSourceModel{
 		ID:           types.StringValue(""),
+		MaxItems:     config.MaxItems,
 		StatusPageID: config.StatusPageID,
-		Users:        make([]StatusPageUserDataSourceItemModel, len(api.Items)),
+		Users:        make([]StatusPageUserDataSourceItemModel, len(items)),
 	}
 
-	for i := range api.Items {
+	for i := range items {
 		model.Users[i] = StatusPageUserDataSourceItemModel{
-			ID:        types.Int64Value(api.Items[i].PK),
-			Email:     types.StringValue(api.Items[i].Email),
-			FirstName: types.StringValue(api.Items[i].FirstName),
-			LastName:  types.StringValue(api.Items[i].LastName),
-			IsActive:  types.BoolValue(api.Items[i].IsActive),
+			ID:        types.Int64Value(items[i].PK),
+			Email:     types.StringValue(items[i].Email),
+			FirstName: types.StringValue(items[i].FirstName),
+			LastName:  types.StringValue(items[i].LastName),
+			IsActive:  types.BoolValue(items[i].IsActive),
 		}
 	}
 
-	rs.Diagnostics = rs.State.Set(ctx, model)
+	rs.Diagnostics.Append(rs.State.Set(ctx, model)...)
 }
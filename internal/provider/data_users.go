@@ -22,6 +22,7 @@ var UsersDataSchema = schema.Schema{
 			Computed:    true,
 			Description: "Placeholder identifier for the data source",
 		},
+		"max_items": MaxItemsSchemaAttribute(),
 		"users": schema.ListNestedAttribute{
 			Computed:    true,
 			Description: "List of all users in the account",
@@ -91,8 +92,9 @@ var UsersDataSchema = schema.Schema{
 }
 
 type UsersDataSourceModel struct {
-	ID    types.String               `tfsdk:"id"`
-	Users []UsersDataSourceItemModel `tfsdk:"users"`
+	ID       types.String               `tfsdk:"id"`
+	MaxItems types.Int64                `tfsdk:"max_items"`
+	Users    []UsersDataSourceItemModel `tfsdk:"users"`
 }
 
 type UsersDataSourceItemModel struct {
@@ -126,27 +128,32 @@ func (d UsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 	rs.Schema = UsersDataSchema
 }
 
-func (d UsersDataSource) Read(ctx context.Context, _ datasource.ReadRequest, rs *datasource.ReadResponse) {
-	const pageSize int64 = 100
-	var users []upapi.User
-	for page := int64(1); ; page++ {
+func (d UsersDataSource) Read(ctx context.Context, rq datasource.ReadRequest, rs *datasource.ReadResponse) {
+	var config UsersDataSourceModel
+	rs.Diagnostics.Append(rq.Config.Get(ctx, &config)...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+
+	users, diags := ListAll(ctx, "users", config.MaxItems, func(ctx context.Context, page, pageSize int64) ([]upapi.User, int64, error) {
 		api, err := d.p.api.Users().List(ctx, upapi.UserListOptions{
 			Page:     page,
 			PageSize: pageSize,
 		})
 		if err != nil {
-			rs.Diagnostics.AddError("API call failed", err.Error())
-			return
-		}
-		users = append(users, api.Items...)
-		if int64(len(api.Items)) < pageSize || int64(len(users)) >= api.TotalCount {
-			break
+			return nil, 0, err
 		}
+		return api.Items, api.TotalCount, nil
+	})
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
+		return
 	}
 
 	model := UsersDataSourceModel{
-		ID:    types.StringValue(""),
-		Users: make([]UsersDataSourceItemModel, len(users)),
+		ID:       types.StringValue(""),
+		MaxItems: config.MaxItems,
+		Users:    make([]UsersDataSourceItemModel, len(users)),
 	}
 
 	for i := range users {
@@ -49,5 +49,16 @@ func TestAccUsersDataSource(t *testing.T) {
 				resource.TestCheckOutput("filtered_user_email", email),
 			),
 		},
+		{
+			Config: `
+data "uptime_users" "first" {
+  max_items = 1
+}
+`,
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("data.uptime_users.first", "max_items", "1"),
+				resource.TestCheckResourceAttr("data.uptime_users.first", "users.#", "1"),
+			),
+		},
 	}))
 }
@@ -43,6 +43,20 @@ func ImportStateSimpleID(ctx context.Context, req resource.ImportStateRequest, r
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
+// ImportStateStatusPageID handles import for resources that exist once per
+// status page. It parses the status page ID and sets it as both the
+// "statuspage_id" and "id" attributes.
+func ImportStateStatusPageID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID",
+			fmt.Sprintf("expected numeric status page ID, got '%s': %s", req.ID, err.Error()))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("statuspage_id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
 // ImportStateCompositeID handles import for child resources with composite IDs.
 // It parses the import ID in format "statuspage_id:resource_id" and sets both attributes.
 func ImportStateCompositeID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+// listableResource is a resource whose objects can be listed by `terraform
+// query`. IdentityAPIResource implements it.
+type listableResource[R any] interface {
+	resource.Resource
+	listResult(context.Context, list.ListRequest, R) list.ListResult
+}
+
+// APIListResource lists the objects of a resource type for `terraform query`.
+// It shares the type name, schema and conversion of the managed resource, and
+// only adds the filters in schema, read into a model of type F.
+type APIListResource[R, F any] struct {
+	res    listableResource[R]
+	schema listschema.Schema
+	// fetch lists a page of objects.
+	fetch ListPageFunc[R]
+	// match drops objects filter excludes; nil keeps all.
+	match func(filter F, item R) bool
+	// displayName names an object in the query output.
+	displayName func(R) string
+}
+
+var _ list.ListResource = APIListResource[upapi.Contact, NameListFilterModel]{}
+
+func (r APIListResource[R, F]) Metadata(ctx context.Context, rq resource.MetadataRequest, rs *resource.MetadataResponse) {
+	r.res.Metadata(ctx, rq, rs)
+}
+
+func (r APIListResource[R, F]) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, rs *list.ListResourceSchemaResponse) {
+	rs.Schema = r.schema
+}
+
+func (r APIListResource[R, F]) List(ctx context.Context, rq list.ListRequest, stream *list.ListResultsStream) {
+	var filter F
+	diags := rq.Config.Get(ctx, &filter)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	pageSize := listPageSize
+	if rq.Limit > 0 {
+		pageSize = min(pageSize, rq.Limit)
+	}
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		err := ListEach(ctx, pageSize, r.fetch, func(item R) bool {
+			if r.match != nil && !r.match(filter, item) {
+				return true
+			}
+			result := r.res.listResult(ctx, rq, item)
+			result.DisplayName = r.displayName(item)
+			count++
+			return push(result) && (rq.Limit <= 0 || count < rq.Limit)
+		})
+		if err != nil {
+			push(list.ListResult{Diagnostics: diag.Diagnostics{diag.NewErrorDiagnostic("API call failed", err.Error())}})
+		}
+	}
+}
+
+// listNameAttribute is the name filter of list resources.
+func listNameAttribute(noun string) listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Optional:    true,
+		Description: "Only list " + noun + " whose name contains this text",
+	}
+}
+
+type NameListFilterModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+// matchName reports whether name contains the name filter. The list endpoints
+// can't search, so the filter is applied to each page.
+func (f NameListFilterModel) matchName(name string) bool {
+	return strings.Contains(name, f.Name.ValueString())
+}
+
+func NewContactListResource(ctx context.Context, p *providerImpl) list.ListResource {
+	return APIListResource[upapi.Contact, NameListFilterModel]{
+		res: NewContactResource(ctx, p).(listableResource[upapi.Contact]),
+		schema: listschema.Schema{
+			Description: "List contacts of the account.",
+			Attributes: map[string]listschema.Attribute{
+				"name": listNameAttribute("contacts"),
+			},
+		},
+		fetch: func(ctx context.Context, page, pageSize int64) ([]upapi.Contact, int64, error) {
+			api, err := p.api.Contacts().List(ctx, upapi.ContactListOptions{
+				Page:     page,
+				PageSize: pageSize,
+			})
+			if err != nil {
+				return nil, 0, err
+			}
+			return api.Items, api.TotalCount, nil
+		},
+		match: func(filter NameListFilterModel, c upapi.Contact) bool {
+			return filter.matchName(c.Name)
+		},
+		displayName: func(c upapi.Contact) string {
+			return c.Name
+		},
+	}
+}
+
+func NewStatusPageListResource(ctx context.Context, p *providerImpl) list.ListResource {
+	return APIListResource[upapi.StatusPage, NameListFilterModel]{
+		res: NewStatusPageResource(ctx, p).(listableResource[upapi.StatusPage]),
+		schema: listschema.Schema{
+			Description: "List status pages of the account.",
+			Attributes: map[string]listschema.Attribute{
+				"name": listNameAttribute("status pages"),
+			},
+		},
+		fetch: func(ctx context.Context, page, pageSize int64) ([]upapi.StatusPage, int64, error) {
+			api, err := p.api.StatusPages().List(ctx, upapi.StatusPageListOptions{
+				Page:     page,
+				PageSize: pageSize,
+			})
+			if err != nil {
+				return nil, 0, err
+			}
+			return api.Items, api.TotalCount, nil
+		},
+		match: func(filter NameListFilterModel, s upapi.StatusPage) bool {
+			return filter.matchName(s.Name)
+		},
+		displayName: func(s upapi.StatusPage) string {
+			return s.Name
+		},
+	}
+}
+
+type CheckListFilterModel struct {
+	NameListFilterModel
+	Tags types.List `tfsdk:"tags"`
+}
+
+// matchTags reports whether tags contains every tag of the tags filter.
+func (f CheckListFilterModel) matchTags(tags []string) bool {
+	for _, v := range f.Tags.Elements() {
+		if tag, ok := v.(types.String); ok && !slices.Contains(tags, tag.ValueString()) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewCheckGroupListResource lists group checks, which are the checks with a
+// group config. The check list results don't tell the other check types
+// apart, so they have no list resource yet.
+func NewCheckGroupListResource(ctx context.Context, p *providerImpl) list.ListResource {
+	return APIListResource[upapi.Check, CheckListFilterModel]{
+		res: NewCheckGroupResource(ctx, p).(listableResource[upapi.Check]),
+		schema: listschema.Schema{
+			Description: "List group checks of the account.",
+			Attributes: map[string]listschema.Attribute{
+				"name": listNameAttribute("group checks"),
+				"tags": listschema.ListAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Description: "Only list group checks with all of these tags",
+				},
+			},
+		},
+		fetch: func(ctx context.Context, page, pageSize int64) ([]upapi.Check, int64, error) {
+			api, err := p.api.Checks().List(ctx, upapi.CheckListOptions{
+				Page:     page,
+				PageSize: pageSize,
+			})
+			if err != nil {
+				return nil, 0, err
+			}
+			return api.Items, api.TotalCount, nil
+		},
+		match: func(filter CheckListFilterModel, c upapi.Check) bool {
+			return c.GroupConfig != nil && filter.matchName(c.Name) && filter.matchTags(c.Tags)
+		},
+		displayName: func(c upapi.Check) string {
+			return c.Name
+		},
+	}
+}
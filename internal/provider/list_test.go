@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	petname "github.com/dustinkirkland/golang-petname"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	testresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+type testListableResource struct {
+	resource.Resource
+}
+
+func (testListableResource) listResult(ctx context.Context, rq list.ListRequest, item int) list.ListResult {
+	return list.ListResult{DisplayName: fmt.Sprint(item)}
+}
+
+func TestAPIListResourceList(t *testing.T) {
+	var fail bool
+	r := APIListResource[int, NameListFilterModel]{
+		res: testListableResource{},
+		schema: listschema.Schema{
+			Attributes: map[string]listschema.Attribute{
+				"name": listNameAttribute("items"),
+			},
+		},
+		fetch: func(_ context.Context, page, pageSize int64) ([]int, int64, error) {
+			if fail {
+				return nil, 0, fmt.Errorf("boom")
+			}
+			items := make([]int, 0, pageSize)
+			for i := (page - 1) * pageSize; i < min(page*pageSize, 250); i++ {
+				items = append(items, int(i))
+			}
+			return items, 250, nil
+		},
+		match: func(filter NameListFilterModel, i int) bool {
+			return i%2 == 0 && filter.matchName(fmt.Sprint(i))
+		},
+		displayName: func(i int) string {
+			return fmt.Sprintf("item %d", i)
+		},
+	}
+
+	config := func(name string) tfsdk.Config {
+		return tfsdk.Config{
+			Schema: r.schema,
+			Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}},
+				map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, name)}),
+		}
+	}
+	collect := func(rq list.ListRequest) []list.ListResult {
+		var stream list.ListResultsStream
+		r.List(context.Background(), rq, &stream)
+		var out []list.ListResult
+		for result := range stream.Results {
+			out = append(out, result)
+		}
+		return out
+	}
+
+	got := collect(list.ListRequest{Config: config("")})
+	if len(got) != 125 || got[1].DisplayName != "item 2" {
+		t.Errorf("got %d results, second %q", len(got), got[1].DisplayName)
+	}
+
+	got = collect(list.ListRequest{Config: config(""), Limit: 10})
+	if len(got) != 10 || got[9].DisplayName != "item 18" {
+		t.Errorf("with limit: got %d results", len(got))
+	}
+
+	got = collect(list.ListRequest{Config: config("24")})
+	if len(got) != 7 || got[0].DisplayName != "item 24" || got[1].DisplayName != "item 124" {
+		t.Errorf("with name: got %v", got)
+	}
+
+	fail = true
+	got = collect(list.ListRequest{Config: config("")})
+	if len(got) != 1 || !got[0].Diagnostics.HasError() || !strings.Contains(got[0].Diagnostics[0].Detail(), "boom") {
+		t.Errorf("on API error: got %v", got)
+	}
+}
+
+func TestCheckListFilterModelMatchTags(t *testing.T) {
+	f := CheckListFilterModel{Tags: types.ListValueMust(types.StringType, []attr.Value{
+		types.StringValue("prod"),
+		types.StringValue("web"),
+	})}
+	if !f.matchTags([]string{"web", "prod", "eu"}) {
+		t.Error("expected a check with all tags to match")
+	}
+	if f.matchTags([]string{"prod"}) {
+		t.Error("expected a check missing a tag not to match")
+	}
+	if f := (CheckListFilterModel{Tags: types.ListNull(types.StringType)}); !f.matchTags(nil) {
+		t.Error("expected no tags filter to match")
+	}
+}
+
+// TestAccContactListResource finds a contact with `terraform query` and
+// imports it the way the configuration written by `terraform query
+// -generate-config-out` does: an import block with the identity from the
+// query result and a generated resource block. The test framework can't pass
+// -generate-config-out to query itself, so the last step runs the same import
+// through `terraform plan -generate-config-out`.
+func TestAccContactListResource(t *testing.T) {
+	name := petname.Generate(3, "-")
+	vars := config.Variables{
+		"name": config.StringVariable(name),
+		"email_list": config.ListVariable(
+			config.StringVariable("nobody@uptime.com"),
+		),
+	}
+	tc := testCaseFromSteps(t, []testresource.TestStep{
+		{
+			ConfigDirectory: config.StaticDirectory("testdata/resource_contact/email_list"),
+			ConfigVariables: vars,
+		},
+		{
+			Query: true,
+			Config: fmt.Sprintf(`
+provider "uptime" {}
+
+list "uptime_contact" "test" {
+  provider = uptime
+
+  config {
+    name = %q
+  }
+}
+`, name),
+			QueryResultChecks: []querycheck.QueryResultCheck{
+				querycheck.ExpectLength("uptime_contact.test", 1),
+				querycheck.ExpectResourceDisplayName("uptime_contact.test",
+					queryfilter.ByDisplayName(knownvalue.StringExact(name)), knownvalue.StringExact(name)),
+			},
+		},
+		{
+			ConfigDirectory: config.StaticDirectory("testdata/resource_contact/email_list"),
+			ConfigVariables: vars,
+			ResourceName:    "uptime_contact.test",
+			ImportState:     true,
+			ImportStateKind: testresource.ImportBlockWithResourceIdentity,
+			GenerateConfig:  true,
+		},
+	})
+	tc.TerraformVersionChecks = []tfversion.TerraformVersionCheck{
+		tfversion.SkipBelow(tfversion.Version1_14_0),
+	}
+	testresource.Test(t, tc)
+}
+
+func TestAccCheckGroupListResource(t *testing.T) {
+	name := petname.Generate(3, "-")
+	tc := testCaseFromSteps(t, []testresource.TestStep{
+		{
+			ConfigDirectory: config.StaticDirectory("testdata/resource_check_group/_basic"),
+			ConfigVariables: config.Variables{
+				"name": config.StringVariable(name),
+			},
+		},
+		{
+			Query: true,
+			Config: fmt.Sprintf(`
+provider "uptime" {}
+
+list "uptime_check_group" "test" {
+  provider = uptime
+
+  config {
+    name = %q
+  }
+}
+`, name),
+			QueryResultChecks: []querycheck.QueryResultCheck{
+				querycheck.ExpectLength("uptime_check_group.test", 1),
+				querycheck.ExpectResourceDisplayName("uptime_check_group.test",
+					queryfilter.ByDisplayName(knownvalue.StringExact(name)), knownvalue.StringExact(name)),
+			},
+		},
+	})
+	tc.TerraformVersionChecks = []tfversion.TerraformVersionCheck{
+		tfversion.SkipBelow(tfversion.Version1_14_0),
+	}
+	testresource.Test(t, tc)
+}
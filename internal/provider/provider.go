@@ -10,21 +10,32 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
 )
 
-var _ provider.Provider = (*providerImpl)(nil)
+var (
+	_ provider.Provider                  = (*providerImpl)(nil)
+	_ provider.ProviderWithListResources = (*providerImpl)(nil)
+)
 
 type providerImpl struct {
-	api           upapi.API
-	version       string
-	locations     map[string]struct{}
-	locationsOnce sync.Once
+	api                 upapi.API
+	version             string
+	locations           map[string]struct{}
+	locationsOnce       sync.Once
+	contactGroups       map[string]struct{}
+	contactGroupsOnce   sync.Once
+	contactGroupsErr    error
+	referenceValidation string
+
+	checkAttributeOwners checkAttributeOwners
 }
 
 type providerConfig struct {
@@ -33,6 +44,8 @@ type providerConfig struct {
 	Token      types.String  `tfsdk:"token"`
 	RateLimit  types.Float64 `tfsdk:"rate_limit"`
 	Trace      types.Bool    `tfsdk:"trace"`
+
+	ReferenceValidation types.String `tfsdk:"reference_validation"`
 }
 
 func (p *providerImpl) Metadata(_ context.Context, _ provider.MetadataRequest, rs *provider.MetadataResponse) {
@@ -61,6 +74,17 @@ func (p *providerImpl) Schema(_ context.Context, _ provider.SchemaRequest, rs *p
 			"trace": schema.BoolAttribute{
 				Optional: true,
 			},
+			"reference_validation": schema.StringAttribute{
+				Optional: true,
+				Description: "How to report contact group names that don't exist in the account at plan time: " +
+					`"warning" (default), "error" or "off". Use "warning" when the referenced objects are created ` +
+					"in the same configuration under names Terraform cannot resolve at plan time.",
+				Validators: []validator.String{
+					OneOfStringValidator([]string{
+						ReferenceValidationWarning, ReferenceValidationError, ReferenceValidationOff,
+					}),
+				},
+			},
 		},
 	}
 }
@@ -123,13 +147,16 @@ func (p *providerImpl) Configure(ctx context.Context, rq provider.ConfigureReque
 		return
 	}
 	p.api = api
+	p.referenceValidation = cfg.ReferenceValidation.ValueString()
 }
 
 func (p *providerImpl) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		func() datasource.DataSource { return NewLocationsDataSource(ctx, p) },
 		func() datasource.DataSource { return NewPrivateLocationsDataSource(ctx, p) },
+		func() datasource.DataSource { return NewProbeIPsDataSource(ctx, p) },
 		func() datasource.DataSource { return NewCredentialsDataSource(ctx, p) },
+		func() datasource.DataSource { return NewContactDataSource(ctx, p) },
 		func() datasource.DataSource { return NewContactsDataSource(ctx, p) },
 		func() datasource.DataSource { return NewUsersDataSource(ctx, p) },
 		func() datasource.DataSource { return NewDashboardsDataSource(ctx, p) },
@@ -139,6 +166,7 @@ func (p *providerImpl) DataSources(ctx context.Context) []func() datasource.Data
 		func() datasource.DataSource { return NewOutagesDataSource(ctx, p) },
 		func() datasource.DataSource { return NewPushNotificationProfilesDataSource(ctx, p) },
 		func() datasource.DataSource { return NewStatusPageDataSource(ctx, p) },
+		func() datasource.DataSource { return NewStatusPageLookupDataSource(ctx, p) },
 		func() datasource.DataSource { return NewStatusPageComponentDataSource(ctx, p) },
 		func() datasource.DataSource { return NewStatusPageCurrentStatusDataSource(ctx, p) },
 		func() datasource.DataSource { return NewStatusPageIncidentDataSource(ctx, p) },
@@ -154,6 +182,7 @@ func (p *providerImpl) DataSources(ctx context.Context) []func() datasource.Data
 
 func (p *providerImpl) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		func() resource.Resource { return NewCheckResource(ctx, p) },
 		func() resource.Resource { return NewCheckAPIResource(ctx, p) },
 		func() resource.Resource { return NewCheckTransactionResource(ctx, p) },
 		func() resource.Resource { return NewCheckBlacklistResource(ctx, p) },
@@ -203,12 +232,15 @@ func (p *providerImpl) Resources(ctx context.Context) []func() resource.Resource
 		func() resource.Resource { return NewCredentialResource(ctx, p) },
 		func() resource.Resource { return NewStatusPageResource(ctx, p) },
 		func() resource.Resource { return NewStatusPageComponentResource(ctx, p) },
+		func() resource.Resource { return NewStatusPageComponentSetResource(ctx, p) },
+		func() resource.Resource { return NewStatusPageLayoutResource(ctx, p) },
 		func() resource.Resource { return NewStatusPageIncidentResource(ctx, p) },
 		func() resource.Resource { return NewStatusPageMetricResource(ctx, p) },
 		func() resource.Resource { return NewStatusPageSubscriberResource(ctx, p) },
 		func() resource.Resource { return NewStatusPageSubsDomainAllowResource(ctx, p) },
 		func() resource.Resource { return NewStatusPageSubsDomainBlockResource(ctx, p) },
 		func() resource.Resource { return NewStatusPageUserResource(ctx, p) },
+		func() resource.Resource { return NewStatusPageUsersResource(ctx, p) },
 		func() resource.Resource { return NewSLAReportResource(ctx, p) },
 		func() resource.Resource { return NewDashboardResource(ctx, p) },
 		func() resource.Resource { return NewTagResource(ctx, p) },
@@ -219,6 +251,15 @@ func (p *providerImpl) Resources(ctx context.Context) []func() resource.Resource
 	}
 }
 
+// ListResources lists objects for `terraform query`.
+func (p *providerImpl) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		func() list.ListResource { return NewContactListResource(ctx, p) },
+		func() list.ListResource { return NewStatusPageListResource(ctx, p) },
+		func() list.ListResource { return NewCheckGroupListResource(ctx, p) },
+	}
+}
+
 func (p *providerImpl) getLocations(ctx context.Context) error {
 	servers, err := p.api.ProbeServers().List(ctx)
 	if err != nil {
@@ -241,6 +282,51 @@ func (p *providerImpl) GetLocations(ctx context.Context) (_ map[string]struct{},
 	return p.locations, nil
 }
 
+func (p *providerImpl) getContactGroups(ctx context.Context) error {
+	contactGroups := make(map[string]struct{})
+	err := ListEach(ctx, listPageSize, func(ctx context.Context, page, pageSize int64) ([]upapi.Contact, int64, error) {
+		res, err := p.api.Contacts().List(ctx, upapi.ContactListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return res.Items, res.TotalCount, nil
+	}, func(item upapi.Contact) bool {
+		contactGroups[item.Name] = struct{}{}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	p.contactGroups = contactGroups
+	return nil
+}
+
+// GetContactGroups lists the contact group names once per provider instance.
+// A failed listing is remembered, so later callers get the same error rather
+// than an empty list.
+func (p *providerImpl) GetContactGroups(ctx context.Context) (map[string]struct{}, error) {
+	p.contactGroupsOnce.Do(func() {
+		p.contactGroupsErr = p.getContactGroups(ctx)
+	})
+	if p.contactGroupsErr != nil {
+		return nil, fmt.Errorf("failed to get list of contact groups: %w", p.contactGroupsErr)
+	}
+	return p.contactGroups, nil
+}
+
+// ReferenceValidation returns the configured reference_validation mode. It is
+// "off" for resources built without a configured provider, which can't list
+// anything.
+func (p *providerImpl) ReferenceValidation() string {
+	if p == nil || p.api == nil {
+		return ReferenceValidationOff
+	}
+	if p.referenceValidation == "" {
+		return ReferenceValidationWarning
+	}
+	return p.referenceValidation
+}
+
 func VersionFactory(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &providerImpl{
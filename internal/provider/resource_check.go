@@ -0,0 +1,419 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// checkTypeResources maps the uptime_check type discriminator to the typed
+// resource implementing it.
+var checkTypeResources = map[string]func(context.Context, *providerImpl) resource.Resource{
+	"API":         NewCheckAPIResource,
+	"BLACKLIST":   NewCheckBlacklistResource,
+	"CLOUDSTATUS": NewCheckCloudStatusResource,
+	"DNS":         NewCheckDNSResource,
+	"GROUP":       NewCheckGroupResource,
+	"HEARTBEAT":   NewCheckHeartbeatResource,
+	"HTTP":        NewCheckHTTPResource,
+	"ICMP":        NewCheckICMPResource,
+	"IMAP":        NewCheckIMAPResource,
+	"MALWARE":     NewCheckMalwareResource,
+	"NTP":         NewCheckNTPResource,
+	"PAGESPEED":   NewCheckPageSpeedResource,
+	"POP":         NewCheckPOPResource,
+	"RDAP":        NewCheckRDAPResource,
+	"RUM2":        NewCheckRUM2Resource,
+	"SMTP":        NewCheckSMTPResource,
+	"SSH":         NewCheckSSHResource,
+	"SSL_CERT":    NewCheckSSLCertResource,
+	"TCP":         NewCheckTCPResource,
+	"TRANSACTION": NewCheckTransactionResource,
+	"UDP":         NewCheckUDPResource,
+	"WEBHOOK":     NewCheckWebhookResource,
+	"WHOIS":       NewCheckWHOISResource,
+}
+
+func NewCheckResource(ctx context.Context, p *providerImpl) resource.Resource {
+	r := &CheckResource{typed: make(map[string]checkTypedResource, len(checkTypeResources))}
+	for t, fn := range checkTypeResources {
+		res := fn(ctx, p)
+		srs := resource.SchemaResponse{}
+		res.Schema(ctx, resource.SchemaRequest{}, &srs)
+		mrs := resource.MetadataResponse{}
+		res.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "uptime"}, &mrs)
+		r.typed[t] = checkTypedResource{
+			Resource:  res,
+			schema:    srs.Schema,
+			checkType: t,
+			typeName:  mrs.TypeName,
+			key:       strings.TrimPrefix(mrs.TypeName, "uptime_check_"),
+		}
+	}
+	names := r.types()
+	attrs := map[string]schema.Attribute{
+		"id": IDSchemaAttribute(),
+		"type": schema.StringAttribute{
+			Required:    true,
+			Description: "Check type, one of " + strings.Join(names, ", ") + ". Changing the type forces a new check.",
+			Validators: []validator.String{
+				OneOfStringValidator(names),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+	for _, t := range names {
+		typed := r.typed[t]
+		attrs[typed.key] = schema.SingleNestedAttribute{
+			Optional: true,
+			Description: fmt.Sprintf("Settings of the check when `type` is `%s`, with the attributes, defaults and "+
+				"validation of `%s`. Required for that type, and must be omitted otherwise.", t, typed.typeName),
+			Attributes: typed.schema.Attributes,
+		}
+	}
+	r.schema = schema.Schema{
+		Description: "Check of any type. `type` selects the check type, and the nested attribute named after the type " +
+			"(`http`, `dns`, ...) holds its settings, exactly as for the matching `uptime_check_<type>` resource. " +
+			"Existing `uptime_check_<type>` resources can be moved to it with a `moved` block (Terraform 1.8+) " +
+			"without recreating the check. " +
+			"Import using the check type and ID: `terraform import uptime_check.example HTTP:123`",
+		Attributes: attrs,
+	}
+	return r
+}
+
+var (
+	_ resource.ResourceWithValidateConfig = (*CheckResource)(nil)
+	_ resource.ResourceWithImportState    = (*CheckResource)(nil)
+	_ resource.ResourceWithMoveState      = (*CheckResource)(nil)
+)
+
+// CheckResource implements uptime_check by handing each operation to the
+// typed resource selected by type, with the value of the nested attribute for
+// that type as its plan, state and config.
+type CheckResource struct {
+	schema schema.Schema
+	typed  map[string]checkTypedResource
+}
+
+type checkTypedResource struct {
+	resource.Resource
+	schema    schema.Schema
+	checkType string // type discriminator, e.g. "HTTP"
+	typeName  string // e.g. "uptime_check_http"
+	key       string // nested attribute name, e.g. "http"
+}
+
+func (r *CheckResource) types() []string {
+	names := make([]string, 0, len(r.typed))
+	for t := range r.typed {
+		names = append(names, t)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (r *CheckResource) Metadata(_ context.Context, rq resource.MetadataRequest, rs *resource.MetadataResponse) {
+	rs.TypeName = rq.ProviderTypeName + "_check"
+}
+
+func (r *CheckResource) Schema(_ context.Context, _ resource.SchemaRequest, rs *resource.SchemaResponse) {
+	rs.Schema = r.schema
+}
+
+// ValidateConfig checks that exactly the nested attribute of the configured
+// type is set, and runs the config validators of the typed resource on it.
+func (r *CheckResource) ValidateConfig(ctx context.Context, rq resource.ValidateConfigRequest, rs *resource.ValidateConfigResponse) {
+	var t types.String
+	rs.Diagnostics.Append(rq.Config.GetAttribute(ctx, path.Root("type"), &t)...)
+	if rs.Diagnostics.HasError() || t.IsNull() || t.IsUnknown() {
+		return
+	}
+	typed, ok := r.typed[t.ValueString()]
+	if !ok {
+		return // reported by the type validator
+	}
+	attrs, err := checkObjectAttributes(rq.Config.Raw)
+	if err != nil {
+		rs.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	for _, other := range r.typed {
+		if other.key != typed.key && !attrs[other.key].IsNull() {
+			rs.Diagnostics.AddAttributeError(path.Root(other.key), "Invalid Check Settings",
+				fmt.Sprintf("`%s` can't be set for a %s check; use `%s` instead.", other.key, t.ValueString(), typed.key))
+		}
+	}
+	nested := attrs[typed.key]
+	if nested.IsNull() {
+		rs.Diagnostics.AddAttributeError(path.Root(typed.key), "Missing Check Settings",
+			fmt.Sprintf("A %s check needs its settings in `%s`.", t.ValueString(), typed.key))
+		return
+	}
+	if !nested.IsKnown() {
+		return
+	}
+	withValidators, ok := typed.Resource.(resource.ResourceWithConfigValidators)
+	if !ok {
+		return
+	}
+	for _, v := range withValidators.ConfigValidators(ctx) {
+		vrs := resource.ValidateConfigResponse{}
+		v.ValidateResource(ctx, resource.ValidateConfigRequest{
+			Config: tfsdk.Config{Schema: typed.schema, Raw: nested},
+		}, &vrs)
+		rs.Diagnostics.Append(checkNestedDiagnostics(typed.key, vrs.Diagnostics)...)
+	}
+}
+
+func (r *CheckResource) Create(ctx context.Context, rq resource.CreateRequest, rs *resource.CreateResponse) {
+	typed, attrs, diags := r.resolve(rq.Plan.Raw)
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+	config, err := checkObjectAttributes(rq.Config.Raw)
+	if err != nil {
+		rs.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	trs := resource.CreateResponse{
+		State:   typed.nullState(ctx),
+		Private: rs.Private,
+	}
+	typed.Create(ctx, resource.CreateRequest{
+		Config:       tfsdk.Config{Schema: typed.schema, Raw: config[typed.key]},
+		Plan:         tfsdk.Plan{Schema: typed.schema, Raw: attrs[typed.key]},
+		ProviderMeta: rq.ProviderMeta,
+	}, &trs)
+	rs.Diagnostics.Append(checkNestedDiagnostics(typed.key, trs.Diagnostics)...)
+	if trs.State.Raw.IsNull() {
+		return
+	}
+	rs.Diagnostics.Append(r.setState(ctx, &rs.State, typed, trs.State.Raw)...)
+}
+
+func (r *CheckResource) Read(ctx context.Context, rq resource.ReadRequest, rs *resource.ReadResponse) {
+	typed, attrs, diags := r.resolve(rq.State.Raw)
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+	state := tfsdk.State{Schema: typed.schema, Raw: attrs[typed.key]}
+	trs := resource.ReadResponse{
+		State:   state,
+		Private: rs.Private,
+	}
+	typed.Read(ctx, resource.ReadRequest{
+		State:        state,
+		Private:      rq.Private,
+		ProviderMeta: rq.ProviderMeta,
+	}, &trs)
+	rs.Diagnostics.Append(checkNestedDiagnostics(typed.key, trs.Diagnostics)...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+	if trs.State.Raw.IsNull() {
+		rs.State.RemoveResource(ctx)
+		return
+	}
+	rs.Diagnostics.Append(r.setState(ctx, &rs.State, typed, trs.State.Raw)...)
+}
+
+func (r *CheckResource) Update(ctx context.Context, rq resource.UpdateRequest, rs *resource.UpdateResponse) {
+	typed, planned, diags := r.resolve(rq.Plan.Raw)
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+	prior, err := checkObjectAttributes(rq.State.Raw)
+	if err != nil {
+		rs.Diagnostics.AddError("Invalid State", err.Error())
+		return
+	}
+	config, err := checkObjectAttributes(rq.Config.Raw)
+	if err != nil {
+		rs.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	trs := resource.UpdateResponse{
+		State:   tfsdk.State{Schema: typed.schema, Raw: planned[typed.key]},
+		Private: rs.Private,
+	}
+	typed.Update(ctx, resource.UpdateRequest{
+		Config:       tfsdk.Config{Schema: typed.schema, Raw: config[typed.key]},
+		Plan:         tfsdk.Plan{Schema: typed.schema, Raw: planned[typed.key]},
+		State:        tfsdk.State{Schema: typed.schema, Raw: prior[typed.key]},
+		Private:      rq.Private,
+		ProviderMeta: rq.ProviderMeta,
+	}, &trs)
+	rs.Diagnostics.Append(checkNestedDiagnostics(typed.key, trs.Diagnostics)...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+	rs.Diagnostics.Append(r.setState(ctx, &rs.State, typed, trs.State.Raw)...)
+}
+
+func (r *CheckResource) Delete(ctx context.Context, rq resource.DeleteRequest, rs *resource.DeleteResponse) {
+	typed, attrs, diags := r.resolve(rq.State.Raw)
+	rs.Diagnostics.Append(diags...)
+	if rs.Diagnostics.HasError() {
+		return
+	}
+	trs := resource.DeleteResponse{
+		State:   tfsdk.State{Schema: typed.schema, Raw: attrs[typed.key]},
+		Private: rs.Private,
+	}
+	typed.Delete(ctx, resource.DeleteRequest{
+		State:        tfsdk.State{Schema: typed.schema, Raw: attrs[typed.key]},
+		Private:      rq.Private,
+		ProviderMeta: rq.ProviderMeta,
+	}, &trs)
+	rs.Diagnostics.Append(checkNestedDiagnostics(typed.key, trs.Diagnostics)...)
+}
+
+// ImportState takes an ID of the form TYPE:ID. The typed resource fills in
+// the rest on the following read.
+func (r *CheckResource) ImportState(ctx context.Context, rq resource.ImportStateRequest, rs *resource.ImportStateResponse) {
+	t, id, ok := strings.Cut(rq.ID, ":")
+	typed, known := r.typed[t]
+	if !ok || !known {
+		rs.Diagnostics.AddError("Invalid Import ID",
+			fmt.Sprintf("expected TYPE:ID with TYPE one of %s, got '%s'", strings.Join(r.types(), ", "), rq.ID))
+		return
+	}
+	pk, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		rs.Diagnostics.AddError("Invalid Import ID",
+			fmt.Sprintf("expected numeric check ID, got '%s': %s", id, err.Error()))
+		return
+	}
+	rs.Diagnostics.Append(rs.State.SetAttribute(ctx, path.Root("id"), pk)...)
+	rs.Diagnostics.Append(rs.State.SetAttribute(ctx, path.Root("type"), t)...)
+	rs.Diagnostics.Append(rs.State.SetAttribute(ctx, path.Root(typed.key).AtName("id"), pk)...)
+}
+
+// MoveState moves the state of an uptime_check_<type> resource into the
+// nested attribute for its type, so a moved block changes nothing remotely.
+func (r *CheckResource) MoveState(context.Context) []resource.StateMover {
+	movers := make([]resource.StateMover, 0, len(r.typed))
+	for _, t := range r.types() {
+		typed := r.typed[t]
+		movers = append(movers, resource.StateMover{
+			SourceSchema: &typed.schema,
+			StateMover: func(ctx context.Context, rq resource.MoveStateRequest, rs *resource.MoveStateResponse) {
+				if rq.SourceTypeName != typed.typeName || !strings.HasSuffix(rq.SourceProviderAddress, "uptime-com/uptime") {
+					return
+				}
+				if rq.SourceState == nil {
+					rs.Diagnostics.AddError("Unable to Move Resource State",
+						fmt.Sprintf("The state of %s could not be read with its current schema.", typed.typeName))
+					return
+				}
+				rs.Diagnostics.Append(r.setState(ctx, &rs.TargetState, typed, rq.SourceState.Raw)...)
+			},
+		})
+	}
+	return movers
+}
+
+// resolve returns the typed resource selected by the type attribute of raw,
+// together with the attributes of raw.
+func (r *CheckResource) resolve(raw tftypes.Value) (checkTypedResource, map[string]tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	attrs, err := checkObjectAttributes(raw)
+	if err != nil {
+		diags.AddError("Invalid Check Value", err.Error())
+		return checkTypedResource{}, nil, diags
+	}
+	var t string
+	if err := attrs["type"].As(&t); err != nil {
+		diags.AddAttributeError(path.Root("type"), "Invalid Check Type", err.Error())
+		return checkTypedResource{}, nil, diags
+	}
+	typed, ok := r.typed[t]
+	if !ok {
+		diags.AddAttributeError(path.Root("type"), "Invalid Check Type",
+			fmt.Sprintf("unknown check type %q", t))
+		return checkTypedResource{}, nil, diags
+	}
+	return typed, attrs, diags
+}
+
+// setState sets dst to the state of a check of the given type whose typed
+// state is value. The nested attributes of the other types are null.
+func (r *CheckResource) setState(ctx context.Context, dst *tfsdk.State, typed checkTypedResource, value tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+	nested, err := checkObjectAttributes(value)
+	if err != nil {
+		diags.AddError("Invalid Check Value", err.Error())
+		return diags
+	}
+	objectType := r.schema.Type().TerraformType(ctx).(tftypes.Object)
+	attrs := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, t := range objectType.AttributeTypes {
+		attrs[name] = tftypes.NewValue(t, nil)
+	}
+	attrs["type"] = tftypes.NewValue(tftypes.String, typed.checkType)
+	attrs["id"] = nested["id"]
+	attrs[typed.key] = value
+	dst.Raw = tftypes.NewValue(objectType, attrs)
+	return diags
+}
+
+func (t checkTypedResource) nullState(ctx context.Context) tfsdk.State {
+	return tfsdk.State{
+		Schema: t.schema,
+		Raw:    tftypes.NewValue(t.schema.Type().TerraformType(ctx), nil),
+	}
+}
+
+// checkObjectAttributes returns the attributes of an object value.
+func checkObjectAttributes(v tftypes.Value) (map[string]tftypes.Value, error) {
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return nil, err
+	}
+	return attrs, nil
+}
+
+// checkNestedDiagnostics moves the attribute paths of diagnostics reported by
+// a typed check resource under the nested attribute holding its settings.
+func checkNestedDiagnostics(key string, in diag.Diagnostics) diag.Diagnostics {
+	out := make(diag.Diagnostics, 0, len(in))
+	for _, d := range in {
+		if dp, ok := d.(diag.DiagnosticWithPath); ok {
+			p := path.Root(key)
+			for _, step := range dp.Path().Steps() {
+				switch s := step.(type) {
+				case path.PathStepAttributeName:
+					p = p.AtName(string(s))
+				case path.PathStepElementKeyInt:
+					p = p.AtListIndex(int(s))
+				case path.PathStepElementKeyString:
+					p = p.AtMapKey(string(s))
+				case path.PathStepElementKeyValue:
+					p = p.AtSetValue(s.Value)
+				}
+			}
+			d = diag.WithPath(p, dp)
+		}
+		out = append(out, d)
+	}
+	return out
+}
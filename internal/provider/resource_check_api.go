@@ -12,21 +12,26 @@ import (
 )
 
 func NewCheckAPIResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckAPIResourceModel, upapi.CheckAPI, upapi.Check](
+	return NewIdentityAPIResource[CheckAPIResourceModel, upapi.CheckAPI, upapi.Check](
 		CheckAPIResourceAPI{provider: p},
 		CheckAPIResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_api",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Multi-step advanced check type that is intended to monitor API such as REST or SOAP. Import using the check ID: `terraform import uptime_check_api.example 123`",
 				Attributes: map[string]schema.Attribute{
 					"id":                        IDSchemaAttribute(),
 					"url":                       URLSchemaAttribute(),
 					"name":                      NameSchemaAttribute(),
-					"contact_groups":            ContactGroupsSchemaAttribute(),
+					"contact_groups":            ContactGroupsSchemaAttribute(p),
 					"locations":                 LocationsSchemaAttribute(p),
 					"tags":                      TagsSchemaAttribute(),
 					"is_paused":                 IsPausedSchemaAttribute(),
+					"on_destroy":                OnDestroySchemaAttribute(),
+					"escalations":               EscalationsSchemaAttribute(p),
+					"maintenance":               MaintenanceSchemaAttribute(p),
 					"interval":                  IntervalSchemaAttribute(5),
 					"threshold":                 ThresholdSchemaAttribute(30),
 					"sensitivity":               SensitivitySchemaAttribute(2),
@@ -54,6 +59,9 @@ type CheckAPIResourceModel struct {
 	Locations              types.Set    `tfsdk:"locations"`
 	Tags                   types.Set    `tfsdk:"tags"`
 	IsPaused               types.Bool   `tfsdk:"is_paused"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	Escalations            types.List   `tfsdk:"escalations"`
+	Maintenance            types.Object `tfsdk:"maintenance"`
 	Interval               types.Int64  `tfsdk:"interval"`
 	Threshold              types.Int64  `tfsdk:"threshold"`
 	Sensitivity            types.Int64  `tfsdk:"sensitivity"`
@@ -77,6 +85,7 @@ type CheckAPIResourceModelAdapter struct {
 	TagsAttributeAdapter
 
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckAPIResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckAPIResourceModel, diag.Diagnostics) {
@@ -129,6 +138,8 @@ func (a CheckAPIResourceModelAdapter) FromAPIResult(api upapi.Check) (_ *CheckAP
 		Locations:              a.LocationsValue(api.Locations),
 		Tags:                   a.TagsValue(api.Tags),
 		IsPaused:               types.BoolValue(api.IsPaused),
+		Escalations:            a.EscalationsNullValue(),
+		Maintenance:            a.MaintenanceNullValue(),
 		Interval:               types.Int64Value(api.Interval),
 		Threshold:              types.Int64Value(api.Threshold),
 		Script:                 RawJsonValue(api.Script),
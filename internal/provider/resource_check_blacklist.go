@@ -12,11 +12,13 @@ import (
 )
 
 func NewCheckBlacklistResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckBlacklistResourceModel, upapi.CheckBlacklist, upapi.Check](
+	return NewIdentityAPIResource[CheckBlacklistResourceModel, upapi.CheckBlacklist, upapi.Check](
 		CheckBlacklistResourceAPI{provider: p},
 		CheckBlacklistResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_blacklist",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Checks your domain against approximately 100 of the most well-known spam blacklists once per day to see if it's included on those lists. Import using the check ID: `terraform import uptime_check_blacklist.example 123`",
 				Attributes: map[string]schema.Attribute{
@@ -24,10 +26,13 @@ func NewCheckBlacklistResource(_ context.Context, p *providerImpl) resource.Reso
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
 					"address":        AddressHostnameSchemaAttributeDescription("Domain name to check"),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"locations":      LocationsOptionalSchemaAttribute(p),
 					"tags":           TagsSchemaAttribute(),
 					"is_paused":      IsPausedSchemaAttribute(),
+					"on_destroy":     OnDestroySchemaAttribute(),
+					"escalations":    EscalationsSchemaAttribute(p),
+					"maintenance":    MaintenanceSchemaAttribute(p),
 					"num_retries":    NumRetriesAttribute(2),
 					"notes":          NotesSchemaAttribute(),
 				},
@@ -41,6 +46,7 @@ type CheckBlacklistResourceModelAdapter struct {
 	ContactGroupsAttributeAdapter
 	LocationsAttributeAdapter
 	TagsAttributeAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckBlacklistResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckBlacklistResourceModel, diag.Diagnostics) {
@@ -74,6 +80,8 @@ func (a CheckBlacklistResourceModelAdapter) FromAPIResult(api upapi.Check) (_ *C
 		Locations:     a.LocationsValue(api.Locations),
 		Tags:          a.TagsValue(api.Tags),
 		IsPaused:      types.BoolValue(api.IsPaused),
+		Escalations:   a.EscalationsNullValue(),
+		Maintenance:   a.MaintenanceNullValue(),
 		Address:       types.StringValue(api.Address),
 		NumRetries:    types.Int64Value(api.NumRetries),
 		Notes:         types.StringValue(api.Notes),
@@ -89,6 +97,9 @@ type CheckBlacklistResourceModel struct {
 	Locations     types.Set    `tfsdk:"locations"`
 	Tags          types.Set    `tfsdk:"tags"`
 	IsPaused      types.Bool   `tfsdk:"is_paused"`
+	OnDestroy     types.String `tfsdk:"on_destroy"`
+	Escalations   types.List   `tfsdk:"escalations"`
+	Maintenance   types.Object `tfsdk:"maintenance"`
 	Address       types.String `tfsdk:"address"`
 	NumRetries    types.Int64  `tfsdk:"num_retries"`
 	Notes         types.String `tfsdk:"notes"`
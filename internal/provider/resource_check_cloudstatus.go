@@ -20,11 +20,13 @@ import (
 )
 
 func NewCheckCloudStatusResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckCloudStatusResourceModel, upapi.CheckCloudStatus, upapi.Check](
+	return NewIdentityAPIResource[CheckCloudStatusResourceModel, upapi.CheckCloudStatus, upapi.Check](
 		CheckCloudStatusResourceAPI{provider: p},
 		CheckCloudStatusResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_cloudstatus",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Monitor a public cloud provider status feed (Cloud Status check). " +
 					"Configure either a single legacy `service_name`, or a `group` plus `monitoring_type` " +
@@ -37,10 +39,13 @@ func NewCheckCloudStatusResource(_ context.Context, p *providerImpl) resource.Re
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"locations":      LocationsOptionalSchemaAttribute(p),
 					"tags":           TagsSchemaAttribute(),
 					"is_paused":      IsPausedSchemaAttribute(),
+					"on_destroy":     OnDestroySchemaAttribute(),
+					"escalations":    EscalationsSchemaAttribute(p),
+					"maintenance":    MaintenanceSchemaAttribute(p),
 					"notify_only_on_down": schema.BoolAttribute{
 						Optional:    true,
 						Computed:    true,
@@ -101,6 +106,9 @@ type CheckCloudStatusResourceModel struct {
 	Locations        types.Set    `tfsdk:"locations"`
 	Tags             types.Set    `tfsdk:"tags"`
 	IsPaused         types.Bool   `tfsdk:"is_paused"`
+	OnDestroy        types.String `tfsdk:"on_destroy"`
+	Escalations      types.List   `tfsdk:"escalations"`
+	Maintenance      types.Object `tfsdk:"maintenance"`
 	NotifyOnlyOnDown types.Bool   `tfsdk:"notify_only_on_down"`
 	ServiceName      types.String `tfsdk:"service_name"`
 	Group            types.Int64  `tfsdk:"group"`
@@ -117,6 +125,7 @@ type CheckCloudStatusResourceModelAdapter struct {
 	ContactGroupsAttributeAdapter
 	LocationsAttributeAdapter
 	TagsAttributeAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckCloudStatusResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckCloudStatusResourceModel, diag.Diagnostics) {
@@ -166,6 +175,8 @@ func (a CheckCloudStatusResourceModelAdapter) FromAPIResult(api upapi.Check) (*C
 		Locations:        a.LocationsValue(api.Locations),
 		Tags:             a.TagsValue(api.Tags),
 		IsPaused:         types.BoolValue(api.IsPaused),
+		Escalations:      a.EscalationsNullValue(),
+		Maintenance:      a.MaintenanceNullValue(),
 		NotifyOnlyOnDown: types.BoolValue(false),
 		ServiceName:      types.StringValue(""),
 		Group:            types.Int64Null(),
@@ -14,21 +14,26 @@ import (
 )
 
 func NewCheckDNSResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckDNSResourceModel, upapi.CheckDNS, upapi.Check](
+	return NewIdentityAPIResource[CheckDNSResourceModel, upapi.CheckDNS, upapi.Check](
 		CheckDNSResourceAPI{provider: p},
 		CheckDNSResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_dns",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Monitor for DNS failures or changes. Import using the check ID: `terraform import uptime_check_dns.example 123`",
 				Attributes: map[string]schema.Attribute{
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"locations":      LocationsSchemaAttribute(p),
 					"tags":           TagsSchemaAttribute(),
 					"is_paused":      IsPausedSchemaAttribute(),
+					"on_destroy":     OnDestroySchemaAttribute(),
+					"escalations":    EscalationsSchemaAttribute(p),
+					"maintenance":    MaintenanceSchemaAttribute(p),
 					"interval":       IntervalSchemaAttribute(5),
 					"threshold":      ThresholdSchemaAttribute(20),
 					"address":        AddressHostnameSchemaAttribute(),
@@ -72,6 +77,9 @@ type CheckDNSResourceModel struct {
 	Locations              types.Set    `tfsdk:"locations"`
 	Tags                   types.Set    `tfsdk:"tags"`
 	IsPaused               types.Bool   `tfsdk:"is_paused"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	Escalations            types.List   `tfsdk:"escalations"`
+	Maintenance            types.Object `tfsdk:"maintenance"`
 	Interval               types.Int64  `tfsdk:"interval"`
 	Threshold              types.Int64  `tfsdk:"threshold"`
 	Address                types.String `tfsdk:"address"`
@@ -96,6 +104,7 @@ type CheckDNSResourceModelAdapter struct {
 	LocationsAttributeAdapter
 	TagsAttributeAdapter
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckDNSResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckDNSResourceModel, diag.Diagnostics) {
@@ -151,6 +160,8 @@ func (a CheckDNSResourceModelAdapter) FromAPIResult(api upapi.Check) (_ *CheckDN
 		Locations:              a.LocationsValue(api.Locations),
 		Tags:                   a.TagsValue(api.Tags),
 		IsPaused:               types.BoolValue(api.IsPaused),
+		Escalations:            a.EscalationsNullValue(),
+		Maintenance:            a.MaintenanceNullValue(),
 		Interval:               types.Int64Value(api.Interval),
 		Threshold:              types.Int64Value(api.Threshold),
 		Address:                types.StringValue(api.Address),
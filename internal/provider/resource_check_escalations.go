@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
 )
@@ -22,42 +23,56 @@ multiple levels of notifications that are triggered after specific wait times wh
 Each escalation level can send alerts to different contact groups and be repeated multiple times.
 
 Note: This resource manages the escalation configuration for an existing check.
-The check must be created first using one of the uptime_check_* resources.`,
+The check must be created first using one of the uptime_check_* resources.
+Escalations can also be set inline with the escalations attribute of the check resource;
+don't use both for the same check.`,
 				Attributes: map[string]schema.Attribute{
 					"check_id": schema.Int64Attribute{
 						Required:    true,
 						Description: "The ID of the check to configure escalations for",
+						PlanModifiers: []planmodifier.Int64{
+							CheckAttributeOwnerPlanModifier(p, "escalations", false),
+						},
 					},
 					"escalations": schema.ListNestedAttribute{
 						Required: true,
 						Description: `List of escalation rules. Each escalation is triggered sequentially
 after the specified wait time. If the list is empty, all escalations will be removed from the check.`,
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"wait_time": schema.Int64Attribute{
-									Required: true,
-									Description: `Time to wait (in seconds) before triggering this escalation level.
+						NestedObject: EscalationsNestedObject(p),
+					},
+				},
+			},
+		},
+		adapter: CheckEscalationsResourceModelAdapter{},
+	}
+}
+
+// EscalationsNestedObject describes one escalation level. It is shared by
+// uptime_check_escalations and the inline escalations attribute of checks.
+func EscalationsNestedObject(p *providerImpl) schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"wait_time": schema.Int64Attribute{
+				Required: true,
+				Description: `Time to wait (in seconds) before triggering this escalation level.
 For the first escalation, this is the time after the initial alert. For subsequent escalations,
 this is the time after the previous escalation.`,
-								},
-								"num_repeats": schema.Int64Attribute{
-									Required: true,
-									Description: `Number of times to repeat this escalation level.
+			},
+			"num_repeats": schema.Int64Attribute{
+				Required: true,
+				Description: `Number of times to repeat this escalation level.
 Use 0 to repeat indefinitely until the check recovers.`,
-								},
-								"contact_groups": schema.SetAttribute{
-									ElementType: types.StringType,
-									Required:    true,
-									Description: `List of contact group names to receive notifications for this escalation level.
+			},
+			"contact_groups": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: `List of contact group names to receive notifications for this escalation level.
 Each contact group can contain multiple contacts (email addresses, phone numbers, or integrations).`,
-								},
-							},
-						},
-					},
+				PlanModifiers: []planmodifier.Set{
+					ContactGroupsPlanModifier(p),
 				},
 			},
 		},
-		adapter: CheckEscalationsResourceModelAdapter{},
 	}
 }
 
@@ -15,19 +15,24 @@ import (
 )
 
 func NewCheckGroupResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckGroupResourceModel, upapi.CheckGroup, upapi.Check](
+	return NewIdentityAPIResource[CheckGroupResourceModel, upapi.CheckGroup, upapi.Check](
 		CheckGroupResourceAPI{provider: p},
 		CheckGroupResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_group",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Combine multiple checks. Import using the check ID: `terraform import uptime_check_group.example 123`",
 				Attributes: map[string]schema.Attribute{
 					"id":                        IDSchemaAttribute(),
 					"name":                      NameSchemaAttribute(),
-					"contact_groups":            ContactGroupsSchemaAttribute(),
+					"contact_groups":            ContactGroupsSchemaAttribute(p),
 					"tags":                      TagsSchemaAttribute(),
 					"is_paused":                 IsPausedSchemaAttribute(),
+					"on_destroy":                OnDestroySchemaAttribute(),
+					"escalations":               EscalationsSchemaAttribute(p),
+					"maintenance":               MaintenanceSchemaAttribute(p),
 					"sla":                       SLASchemaAttribute(),
 					"notes":                     NotesSchemaAttribute(),
 					"include_in_global_metrics": IncludeInGlobalMetricsSchemaAttribute(),
@@ -104,6 +109,9 @@ type CheckGroupResourceModel struct {
 	ContactGroups          types.Set    `tfsdk:"contact_groups"`
 	Tags                   types.Set    `tfsdk:"tags"`
 	IsPaused               types.Bool   `tfsdk:"is_paused"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	Escalations            types.List   `tfsdk:"escalations"`
+	Maintenance            types.Object `tfsdk:"maintenance"`
 	Notes                  types.String `tfsdk:"notes"`
 	IncludeInGlobalMetrics types.Bool   `tfsdk:"include_in_global_metrics"`
 	SLA                    types.Object `tfsdk:"sla"`
@@ -195,6 +203,7 @@ type CheckGroupResourceModelAdapter struct {
 	ContactGroupsAttributeAdapter
 	TagsAttributeAdapter
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckGroupResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckGroupResourceModel, diag.Diagnostics) {
@@ -255,6 +264,8 @@ func (a CheckGroupResourceModelAdapter) FromAPIResult(api upapi.Check) (_ *Check
 		ContactGroups:          a.ContactGroupsValue(api.ContactGroups),
 		Tags:                   a.TagsValue(api.Tags),
 		IsPaused:               types.BoolValue(api.IsPaused),
+		Escalations:            a.EscalationsNullValue(),
+		Maintenance:            a.MaintenanceNullValue(),
 		Notes:                  types.StringValue(api.Notes),
 		IncludeInGlobalMetrics: types.BoolValue(api.IncludeInGlobalMetrics),
 		SLA: a.SLAAttributeValue(SLAAttribute{
@@ -13,20 +13,25 @@ import (
 )
 
 func NewCheckHeartbeatResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckHeartbeatResourceModel, upapi.CheckHeartbeat, upapi.Check](
+	return NewIdentityAPIResource[CheckHeartbeatResourceModel, upapi.CheckHeartbeat, upapi.Check](
 		CheckHeartbeatResourceAPI{provider: p},
 		CheckHeartbeatResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_heartbeat",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Monitor a periodic process, such as Cron, and issue alerts if the expected interval is exceeded. Import using the check ID: `terraform import uptime_check_heartbeat.example 123`",
 				Attributes: map[string]schema.Attribute{
 					"id":                        IDSchemaAttribute(),
 					"url":                       URLSchemaAttribute(),
 					"name":                      NameSchemaAttribute(),
-					"contact_groups":            ContactGroupsSchemaAttribute(),
+					"contact_groups":            ContactGroupsSchemaAttribute(p),
 					"tags":                      TagsSchemaAttribute(),
 					"is_paused":                 IsPausedSchemaAttribute(),
+					"on_destroy":                OnDestroySchemaAttribute(),
+					"escalations":               EscalationsSchemaAttribute(p),
+					"maintenance":               MaintenanceSchemaAttribute(p),
 					"interval":                  IntervalSchemaAttribute(5),
 					"notes":                     NotesSchemaAttribute(),
 					"include_in_global_metrics": IncludeInGlobalMetricsSchemaAttribute(),
@@ -49,6 +54,9 @@ type CheckHeartbeatResourceModel struct {
 	ContactGroups          types.Set    `tfsdk:"contact_groups"`
 	Tags                   types.Set    `tfsdk:"tags"`
 	IsPaused               types.Bool   `tfsdk:"is_paused"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	Escalations            types.List   `tfsdk:"escalations"`
+	Maintenance            types.Object `tfsdk:"maintenance"`
 	Interval               types.Int64  `tfsdk:"interval"`
 	Notes                  types.String `tfsdk:"notes"`
 	IncludeInGlobalMetrics types.Bool   `tfsdk:"include_in_global_metrics"`
@@ -62,6 +70,7 @@ type CheckHeartbeatResourceModelAdapter struct {
 	ContactGroupsAttributeAdapter
 	TagsAttributeAdapter
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckHeartbeatResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckHeartbeatResourceModel, diag.Diagnostics) {
@@ -109,6 +118,8 @@ func (a CheckHeartbeatResourceModelAdapter) FromAPIResult(api upapi.Check) (_ *C
 		ContactGroups:          a.ContactGroupsValue(api.ContactGroups),
 		Tags:                   a.TagsValue(api.Tags),
 		IsPaused:               types.BoolValue(api.IsPaused),
+		Escalations:            a.EscalationsNullValue(),
+		Maintenance:            a.MaintenanceNullValue(),
 		Interval:               types.Int64Value(api.Interval),
 		Notes:                  types.StringValue(api.Notes),
 		IncludeInGlobalMetrics: types.BoolValue(api.IncludeInGlobalMetrics),
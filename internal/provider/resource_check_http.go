@@ -17,11 +17,13 @@ import (
 )
 
 func NewCheckHTTPResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckHTTPResourceModel, upapi.CheckHTTP, upapi.Check](
+	return NewIdentityAPIResource[CheckHTTPResourceModel, upapi.CheckHTTP, upapi.Check](
 		CheckHTTPResourceAPI{provider: p},
 		CheckHTTPResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_http",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Monitor a URL for specific status code(s). Import using the check ID: `terraform import uptime_check_http.example 123`",
 				Attributes: map[string]schema.Attribute{
@@ -29,10 +31,13 @@ func NewCheckHTTPResource(_ context.Context, p *providerImpl) resource.Resource
 					"url":                       URLSchemaAttribute(),
 					"name":                      NameSchemaAttribute(),
 					"address":                   AddressURLSchemaAttribute(),
-					"contact_groups":            ContactGroupsSchemaAttribute(),
+					"contact_groups":            ContactGroupsSchemaAttribute(p),
 					"locations":                 LocationsSchemaAttribute(p),
 					"tags":                      TagsSchemaAttribute(),
 					"is_paused":                 IsPausedSchemaAttribute(),
+					"on_destroy":                OnDestroySchemaAttribute(),
+					"escalations":               EscalationsSchemaAttribute(p),
+					"maintenance":               MaintenanceSchemaAttribute(p),
 					"interval":                  IntervalSchemaAttribute(5),
 					"threshold":                 ThresholdSchemaAttribute(40),
 					"sensitivity":               SensitivitySchemaAttribute(2),
@@ -122,6 +127,9 @@ type CheckHTTPResourceModel struct {
 	Locations              types.Set    `tfsdk:"locations"`
 	Tags                   types.Set    `tfsdk:"tags"`
 	IsPaused               types.Bool   `tfsdk:"is_paused"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	Escalations            types.List   `tfsdk:"escalations"`
+	Maintenance            types.Object `tfsdk:"maintenance"`
 	Interval               types.Int64  `tfsdk:"interval"`
 	Address                types.String `tfsdk:"address"`
 	Port                   types.Int64  `tfsdk:"port"`
@@ -156,6 +164,7 @@ type CheckHTTPResourceModelAdapter struct {
 	TagsAttributeAdapter
 	HeadersAttributeAdapter
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckHTTPResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckHTTPResourceModel, diag.Diagnostics) {
@@ -224,6 +233,8 @@ func (a CheckHTTPResourceModelAdapter) FromAPIResult(api upapi.Check) (*CheckHTT
 		Locations:              a.LocationsValue(api.Locations),
 		Tags:                   a.TagsValue(api.Tags),
 		IsPaused:               types.BoolValue(api.IsPaused),
+		Escalations:            a.EscalationsNullValue(),
+		Maintenance:            a.MaintenanceNullValue(),
 		Interval:               types.Int64Value(api.Interval),
 		Address:                types.StringValue(api.Address),
 		Port:                   types.Int64Value(api.Port),
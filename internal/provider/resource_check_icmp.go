@@ -13,11 +13,13 @@ import (
 )
 
 func NewCheckICMPResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckICMPResourceModel, upapi.CheckICMP, upapi.Check](
+	return NewIdentityAPIResource[CheckICMPResourceModel, upapi.CheckICMP, upapi.Check](
 		CheckICMPResourceAPI{provider: p},
 		CheckICMPResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_icmp",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Monitor network activity for a specific domain or IP address. Import using the check ID: `terraform import uptime_check_icmp.example 123`",
 				Attributes: map[string]schema.Attribute{
@@ -25,10 +27,13 @@ func NewCheckICMPResource(_ context.Context, p *providerImpl) resource.Resource
 					"url":                       URLSchemaAttribute(),
 					"name":                      NameSchemaAttribute(),
 					"address":                   AddressHostnameOrIPSchemaAttribute(),
-					"contact_groups":            ContactGroupsSchemaAttribute(),
+					"contact_groups":            ContactGroupsSchemaAttribute(p),
 					"locations":                 LocationsSchemaAttribute(p),
 					"tags":                      TagsSchemaAttribute(),
 					"is_paused":                 IsPausedSchemaAttribute(),
+					"on_destroy":                OnDestroySchemaAttribute(),
+					"escalations":               EscalationsSchemaAttribute(p),
+					"maintenance":               MaintenanceSchemaAttribute(p),
 					"interval":                  IntervalSchemaAttribute(5),
 					"num_retries":               NumRetriesAttribute(2),
 					"use_ip_version":            UseIPVersionSchemaAttribute(),
@@ -52,6 +57,9 @@ type CheckICMPResourceModel struct {
 	Locations              types.Set    `tfsdk:"locations"`
 	Tags                   types.Set    `tfsdk:"tags"`
 	IsPaused               types.Bool   `tfsdk:"is_paused"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	Escalations            types.List   `tfsdk:"escalations"`
+	Maintenance            types.Object `tfsdk:"maintenance"`
 	Interval               types.Int64  `tfsdk:"interval"`
 	NumRetries             types.Int64  `tfsdk:"num_retries"`
 	UseIPVersion           types.String `tfsdk:"use_ip_version"`
@@ -72,6 +80,7 @@ type CheckICMPResourceModelAdapter struct {
 	LocationsAttributeAdapter
 	TagsAttributeAdapter
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckICMPResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckICMPResourceModel, diag.Diagnostics) {
@@ -125,6 +134,8 @@ func (a CheckICMPResourceModelAdapter) FromAPIResult(api upapi.Check) (*CheckICM
 		Locations:              a.LocationsValue(api.Locations),
 		Tags:                   a.TagsValue(api.Tags),
 		IsPaused:               types.BoolValue(api.IsPaused),
+		Escalations:            a.EscalationsNullValue(),
+		Maintenance:            a.MaintenanceNullValue(),
 		Interval:               types.Int64Value(api.Interval),
 		NumRetries:             types.Int64Value(api.NumRetries),
 		UseIPVersion:           types.StringValue(api.UseIPVersion),
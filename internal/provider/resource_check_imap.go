@@ -14,11 +14,13 @@ import (
 )
 
 func NewCheckIMAPResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckIMAPResourceModel, upapi.CheckIMAP, upapi.Check](
+	return NewIdentityAPIResource[CheckIMAPResourceModel, upapi.CheckIMAP, upapi.Check](
 		CheckIMAPResourceAPI{provider: p},
 		CheckIMAPResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_imap",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Monitor IMAP server availability. Import using the check ID: `terraform import uptime_check_imap.example 123`",
 				Attributes: map[string]schema.Attribute{
@@ -26,10 +28,13 @@ func NewCheckIMAPResource(_ context.Context, p *providerImpl) resource.Resource
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
 					"address":        AddressHostnameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"locations":      LocationsSchemaAttribute(p),
 					"tags":           TagsSchemaAttribute(),
 					"is_paused":      IsPausedSchemaAttribute(),
+					"on_destroy":     OnDestroySchemaAttribute(),
+					"escalations":    EscalationsSchemaAttribute(p),
+					"maintenance":    MaintenanceSchemaAttribute(p),
 					"interval":       IntervalSchemaAttribute(5),
 					"port":           PortSchemaAttribute(143),
 					"expect_string":  StringToExpectSchemaAttribute(),
@@ -60,6 +65,9 @@ type CheckIMAPResourceModel struct {
 	Locations              types.Set    `tfsdk:"locations"`
 	Tags                   types.Set    `tfsdk:"tags"`
 	IsPaused               types.Bool   `tfsdk:"is_paused"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	Escalations            types.List   `tfsdk:"escalations"`
+	Maintenance            types.Object `tfsdk:"maintenance"`
 	Interval               types.Int64  `tfsdk:"interval"`
 	Port                   types.Int64  `tfsdk:"port"`
 	ExpectString           types.String `tfsdk:"expect_string"`
@@ -82,6 +90,7 @@ type CheckIMAPResourceModelAdapter struct {
 	LocationsAttributeAdapter
 	TagsAttributeAdapter
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckIMAPResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckIMAPResourceModel, diag.Diagnostics) {
@@ -137,6 +146,8 @@ func (a CheckIMAPResourceModelAdapter) FromAPIResult(api upapi.Check) (*CheckIMA
 		Locations:              a.LocationsValue(api.Locations),
 		Tags:                   a.TagsValue(api.Tags),
 		IsPaused:               types.BoolValue(api.IsPaused),
+		Escalations:            a.EscalationsNullValue(),
+		Maintenance:            a.MaintenanceNullValue(),
 		Interval:               types.Int64Value(api.Interval),
 		Port:                   types.Int64Value(api.Port),
 		ExpectString:           types.StringValue(api.ExpectString),
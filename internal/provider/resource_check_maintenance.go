@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -22,108 +23,118 @@ import (
 )
 
 func NewCheckMaintenanceResource(_ context.Context, p *providerImpl) resource.Resource {
+	attrs := CheckMaintenanceAttributes()
+	attrs["check_id"] = schema.Int64Attribute{
+		Required: true,
+		PlanModifiers: []planmodifier.Int64{
+			CheckAttributeOwnerPlanModifier(p, "maintenance", false),
+		},
+	}
 	return APIResource[CheckMaintenanceResourceModel, CheckMaintenanceWrapper, CheckMaintenanceWrapper]{
 		api: &CheckMaintenanceResourceAPI{provider: p},
 		mod: CheckMaintenanceResourceModelAdapter{},
 		meta: APIResourceMetadata{
 			TypeNameSuffix: "check_maintenance",
 			Schema: schema.Schema{
-				Description: "Set maintenance windows for a check",
+				Description: "Set maintenance windows for a check. They can also be set inline with the maintenance attribute of the check resource; don't use both for the same check.",
+				Attributes:  attrs,
+			},
+		},
+	}
+}
+
+// CheckMaintenanceAttributes returns the maintenance settings shared by
+// uptime_check_maintenance and the inline maintenance attribute of checks.
+func CheckMaintenanceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"state": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString("ACTIVE"),
+			Validators: []validator.String{
+				OneOfStringValidator([]string{"SUPPRESSED", "ACTIVE", "SCHEDULED"}),
+			},
+		},
+		"pause_on_scheduled_maintenance": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "Whether to pause the check during scheduled maintenance windows",
+		},
+		"schedule": schema.ListNestedAttribute{
+			Optional: true,
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
 				Attributes: map[string]schema.Attribute{
-					"check_id": schema.Int64Attribute{
-						Required: true,
+					"type": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Validators: []validator.String{
+							OneOfStringValidator([]string{"WEEKLY", "MONTHLY", "ONCE"}),
+						},
+					},
+					"from_time": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^([01]?[0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$`),
+								"Time must be in HH:MM format, 00:00:00 - 23:59:59",
+							),
+						},
 					},
-					"state": schema.StringAttribute{
+					"to_time": schema.StringAttribute{
 						Optional: true,
 						Computed: true,
-						Default:  stringdefault.StaticString("ACTIVE"),
 						Validators: []validator.String{
-							OneOfStringValidator([]string{"SUPPRESSED", "ACTIVE", "SCHEDULED"}),
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^([01]?[0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$`),
+								"Time must be in HH:MM format, 00:00:00 - 23:59:59",
+							),
 						},
 					},
-					"pause_on_scheduled_maintenance": schema.BoolAttribute{
+					"weekdays": schema.SetAttribute{
 						Optional:    true,
 						Computed:    true,
-						Description: "Whether to pause the check during scheduled maintenance windows",
+						ElementType: types.Int32Type,
+						Validators: []validator.Set{
+							setvalidator.SizeBetween(0, 7),
+							setvalidator.ValueInt32sAre(
+								int32validator.Between(0, 6),
+							),
+						},
 					},
-					"schedule": schema.ListNestedAttribute{
+					"monthday": schema.Int32Attribute{
 						Optional: true,
 						Computed: true,
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"type": schema.StringAttribute{
-									Optional: true,
-									Computed: true,
-									Validators: []validator.String{
-										OneOfStringValidator([]string{"WEEKLY", "MONTHLY", "ONCE"}),
-									},
-								},
-								"from_time": schema.StringAttribute{
-									Optional: true,
-									Computed: true,
-									Validators: []validator.String{
-										stringvalidator.RegexMatches(
-											regexp.MustCompile(`^([01]?[0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$`),
-											"Time must be in HH:MM format, 00:00:00 - 23:59:59",
-										),
-									},
-								},
-								"to_time": schema.StringAttribute{
-									Optional: true,
-									Computed: true,
-									Validators: []validator.String{
-										stringvalidator.RegexMatches(
-											regexp.MustCompile(`^([01]?[0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$`),
-											"Time must be in HH:MM format, 00:00:00 - 23:59:59",
-										),
-									},
-								},
-								"weekdays": schema.SetAttribute{
-									Optional:    true,
-									Computed:    true,
-									ElementType: types.Int32Type,
-									Validators: []validator.Set{
-										setvalidator.SizeBetween(0, 7),
-										setvalidator.ValueInt32sAre(
-											int32validator.Between(0, 6),
-										),
-									},
-								},
-								"monthday": schema.Int32Attribute{
-									Optional: true,
-									Computed: true,
-									Default:  int32default.StaticInt32(0),
-									Validators: []validator.Int32{
-										int32validator.Between(0, 30),
-									},
-								},
-								"monthday_from": schema.Int32Attribute{
-									Optional: true,
-									Computed: true,
-									Default:  int32default.StaticInt32(0),
-									Validators: []validator.Int32{
-										int32validator.Between(0, 30),
-									},
-								},
-								"monthday_to": schema.Int32Attribute{
-									Optional: true,
-									Computed: true,
-									Default:  int32default.StaticInt32(0),
-									Validators: []validator.Int32{
-										int32validator.Between(0, 30),
-									},
-								},
-								"once_start_date": schema.StringAttribute{
-									Optional:   true,
-									CustomType: timetypes.RFC3339Type{},
-								},
-								"once_end_date": schema.StringAttribute{
-									Optional:   true,
-									CustomType: timetypes.RFC3339Type{},
-								},
-							},
+						Default:  int32default.StaticInt32(0),
+						Validators: []validator.Int32{
+							int32validator.Between(0, 30),
 						},
 					},
+					"monthday_from": schema.Int32Attribute{
+						Optional: true,
+						Computed: true,
+						Default:  int32default.StaticInt32(0),
+						Validators: []validator.Int32{
+							int32validator.Between(0, 30),
+						},
+					},
+					"monthday_to": schema.Int32Attribute{
+						Optional: true,
+						Computed: true,
+						Default:  int32default.StaticInt32(0),
+						Validators: []validator.Int32{
+							int32validator.Between(0, 30),
+						},
+					},
+					"once_start_date": schema.StringAttribute{
+						Optional:   true,
+						CustomType: timetypes.RFC3339Type{},
+					},
+					"once_end_date": schema.StringAttribute{
+						Optional:   true,
+						CustomType: timetypes.RFC3339Type{},
+					},
 				},
 			},
 		},
@@ -11,11 +11,13 @@ import (
 )
 
 func NewCheckMalwareResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckMalwareResourceModel, upapi.CheckMalware, upapi.Check](
+	return NewIdentityAPIResource[CheckMalwareResourceModel, upapi.CheckMalware, upapi.Check](
 		CheckMalwareResourceAPI{provider: p},
 		CheckMalwareResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_malware",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Monitor URL for viruses or malware. Import using the check ID: `terraform import uptime_check_malware.example 123`",
 				Attributes: map[string]schema.Attribute{
@@ -23,10 +25,13 @@ func NewCheckMalwareResource(_ context.Context, p *providerImpl) resource.Resour
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
 					"address":        AddressHostnameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"locations":      LocationsOptionalSchemaAttribute(p),
 					"tags":           TagsSchemaAttribute(),
 					"is_paused":      IsPausedSchemaAttribute(),
+					"on_destroy":     OnDestroySchemaAttribute(),
+					"escalations":    EscalationsSchemaAttribute(p),
+					"maintenance":    MaintenanceSchemaAttribute(p),
 					"num_retries":    NumRetriesSchemaAttribute(2),
 					"notes":          NotesSchemaAttribute(),
 
@@ -49,6 +54,9 @@ type CheckMalwareResourceModel struct {
 	Locations     types.Set    `tfsdk:"locations"`
 	Tags          types.Set    `tfsdk:"tags"`
 	IsPaused      types.Bool   `tfsdk:"is_paused"`
+	OnDestroy     types.String `tfsdk:"on_destroy"`
+	Escalations   types.List   `tfsdk:"escalations"`
+	Maintenance   types.Object `tfsdk:"maintenance"`
 	NumRetries    types.Int64  `tfsdk:"num_retries"`
 	Notes         types.String `tfsdk:"notes"`
 	SLA           types.Object `tfsdk:"sla"`
@@ -65,6 +73,7 @@ type CheckMalwareResourceModelAdapter struct {
 	LocationsAttributeAdapter
 	TagsAttributeAdapter
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckMalwareResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckMalwareResourceModel, diag.Diagnostics) {
@@ -111,6 +120,8 @@ func (a CheckMalwareResourceModelAdapter) FromAPIResult(api upapi.Check) (*Check
 		Locations:     a.LocationsValue(api.Locations),
 		Tags:          a.TagsValue(api.Tags),
 		IsPaused:      types.BoolValue(api.IsPaused),
+		Escalations:   a.EscalationsNullValue(),
+		Maintenance:   a.MaintenanceNullValue(),
 		NumRetries:    types.Int64Value(api.NumRetries),
 		Notes:         types.StringValue(api.Notes),
 		SLA: a.SLAAttributeValue(SLAAttribute{
@@ -13,11 +13,13 @@ import (
 )
 
 func NewCheckNTPResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckNTPResourceModel, upapi.CheckNTP, upapi.Check](
+	return NewIdentityAPIResource[CheckNTPResourceModel, upapi.CheckNTP, upapi.Check](
 		CheckNTPResourceAPI{provider: p},
 		CheckNTPResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_ntp",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Monitor a Network Time Protocol server. Import using the check ID: `terraform import uptime_check_ntp.example 123`",
 				Attributes: map[string]schema.Attribute{
@@ -26,10 +28,13 @@ func NewCheckNTPResource(_ context.Context, p *providerImpl) resource.Resource {
 					"name":                      NameSchemaAttribute(),
 					"address":                   AddressHostnameSchemaAttribute(),
 					"port":                      PortSchemaAttribute(123),
-					"contact_groups":            ContactGroupsSchemaAttribute(),
+					"contact_groups":            ContactGroupsSchemaAttribute(p),
 					"locations":                 LocationsSchemaAttribute(p),
 					"tags":                      TagsSchemaAttribute(),
 					"is_paused":                 IsPausedSchemaAttribute(),
+					"on_destroy":                OnDestroySchemaAttribute(),
+					"escalations":               EscalationsSchemaAttribute(p),
+					"maintenance":               MaintenanceSchemaAttribute(p),
 					"interval":                  IntervalSchemaAttribute(5),
 					"threshold":                 ThresholdSchemaAttribute(20),
 					"sensitivity":               SensitivitySchemaAttribute(2),
@@ -54,6 +59,9 @@ type CheckNTPResourceModel struct {
 	Locations              types.Set    `tfsdk:"locations"`
 	Tags                   types.Set    `tfsdk:"tags"`
 	IsPaused               types.Bool   `tfsdk:"is_paused"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	Escalations            types.List   `tfsdk:"escalations"`
+	Maintenance            types.Object `tfsdk:"maintenance"`
 	Interval               types.Int64  `tfsdk:"interval"`
 	Address                types.String `tfsdk:"address"`
 	Port                   types.Int64  `tfsdk:"port"`
@@ -77,6 +85,7 @@ type CheckNTPResourceModelAdapter struct {
 	LocationsAttributeAdapter
 	TagsAttributeAdapter
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckNTPResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckNTPResourceModel, diag.Diagnostics) {
@@ -133,6 +142,8 @@ func (a CheckNTPResourceModelAdapter) FromAPIResult(api upapi.Check) (*CheckNTPR
 		Locations:              a.LocationsValue(api.Locations),
 		Tags:                   a.TagsValue(api.Tags),
 		IsPaused:               types.BoolValue(api.IsPaused),
+		Escalations:            a.EscalationsNullValue(),
+		Maintenance:            a.MaintenanceNullValue(),
 		Interval:               types.Int64Value(api.Interval),
 		Threshold:              types.Int64Value(api.Threshold),
 		Sensitivity:            types.Int64Value(api.Sensitivity),
@@ -11,21 +11,26 @@ import (
 )
 
 func NewCheckRDAPResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckRDAPResourceModel, upapi.CheckRDAP, upapi.Check](
+	return NewIdentityAPIResource[CheckRDAPResourceModel, upapi.CheckRDAP, upapi.Check](
 		CheckRDAPResourceAPI{provider: p},
 		CheckRDAPResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_rdap",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Monitor domain's expiry date and registration details using RDAP (Registration Data Access Protocol). Import using the check ID: `terraform import uptime_check_rdap.example 123`",
 				Attributes: map[string]schema.Attribute{
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"locations":      LocationsOptionalSchemaAttribute(p),
 					"tags":           TagsSchemaAttribute(),
 					"is_paused":      IsPausedSchemaAttribute(),
+					"on_destroy":     OnDestroySchemaAttribute(),
+					"escalations":    EscalationsSchemaAttribute(p),
+					"maintenance":    MaintenanceSchemaAttribute(p),
 					"threshold":      ThresholdDescriptionSchemaAttribute(20, "Raise an alert if there are less than this many days before the domain needs to be renewed."),
 					"num_retries":    NumRetriesSchemaAttribute(2),
 					"notes":          NotesSchemaAttribute(),
@@ -51,6 +56,9 @@ type CheckRDAPResourceModel struct {
 	Locations                 types.Set     `tfsdk:"locations"`
 	Tags                      types.Set     `tfsdk:"tags"`
 	IsPaused                  types.Bool    `tfsdk:"is_paused"`
+	OnDestroy                 types.String  `tfsdk:"on_destroy"`
+	Escalations               types.List    `tfsdk:"escalations"`
+	Maintenance               types.Object  `tfsdk:"maintenance"`
 	Address                   types.String  `tfsdk:"address"`
 	ExpectString              types.String  `tfsdk:"expect_string"`
 	Threshold                 types.Int64   `tfsdk:"threshold"`
@@ -70,6 +78,7 @@ type CheckRDAPResourceModelAdapter struct {
 	LocationsAttributeAdapter
 	TagsAttributeAdapter
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckRDAPResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckRDAPResourceModel, diag.Diagnostics) {
@@ -117,6 +126,8 @@ func (a CheckRDAPResourceModelAdapter) FromAPIResult(api upapi.Check) (*CheckRDA
 		Locations:     a.LocationsValue(api.Locations),
 		Tags:          a.TagsValue(api.Tags),
 		IsPaused:      types.BoolValue(api.IsPaused),
+		Escalations:   a.EscalationsNullValue(),
+		Maintenance:   a.MaintenanceNullValue(),
 		Address:       types.StringValue(api.Address),
 		ExpectString:  types.StringValue(api.ExpectString),
 		Threshold:     types.Int64Value(api.Threshold),
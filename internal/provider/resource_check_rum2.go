@@ -12,20 +12,25 @@ import (
 )
 
 func NewCheckRUM2Resource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckRUM2ResourceModel, upapi.CheckRUM2, upapi.Check](
+	return NewIdentityAPIResource[CheckRUM2ResourceModel, upapi.CheckRUM2, upapi.Check](
 		CheckRUM2ResourceAPI{provider: p},
 		CheckRUM2ResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_rum2",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Create a new Real User Monitoring check. Import using the check ID: `terraform import uptime_check_rum2.example 123`",
 				Attributes: map[string]schema.Attribute{
 					"id":                        IDSchemaAttribute(),
 					"url":                       URLSchemaAttribute(),
 					"name":                      NameSchemaAttribute(),
-					"contact_groups":            ContactGroupsSchemaAttribute(),
+					"contact_groups":            ContactGroupsSchemaAttribute(p),
 					"tags":                      TagsSchemaAttribute(),
 					"is_paused":                 IsPausedSchemaAttribute(),
+					"on_destroy":                OnDestroySchemaAttribute(),
+					"escalations":               EscalationsSchemaAttribute(p),
+					"maintenance":               MaintenanceSchemaAttribute(p),
 					"address":                   AddressHostnameSchemaAttribute(),
 					"sla_uptime":                SLAUptimeSchemaAttribute(),
 					"notes":                     NotesSchemaAttribute(),
@@ -44,6 +49,9 @@ type CheckRUM2ResourceModel struct {
 	ContactGroups          types.Set    `tfsdk:"contact_groups"`
 	Tags                   types.Set    `tfsdk:"tags"`
 	IsPaused               types.Bool   `tfsdk:"is_paused"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	Escalations            types.List   `tfsdk:"escalations"`
+	Maintenance            types.Object `tfsdk:"maintenance"`
 	Address                types.String `tfsdk:"address"`
 	Notes                  types.String `tfsdk:"notes"`
 	IncludeInGlobalMetrics types.Bool   `tfsdk:"include_in_global_metrics"`
@@ -57,6 +65,7 @@ func (m CheckRUM2ResourceModel) PrimaryKey() upapi.PrimaryKey {
 type CheckRUM2ResourceModelAdapter struct {
 	ContactGroupsAttributeAdapter
 	TagsAttributeAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckRUM2ResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckRUM2ResourceModel, diag.Diagnostics) {
@@ -92,6 +101,8 @@ func (a CheckRUM2ResourceModelAdapter) FromAPIResult(api upapi.Check) (*CheckRUM
 		ContactGroups:          a.ContactGroupsValue(api.ContactGroups),
 		Tags:                   a.TagsValue(api.Tags),
 		IsPaused:               types.BoolValue(api.IsPaused),
+		Escalations:            a.EscalationsNullValue(),
+		Maintenance:            a.MaintenanceNullValue(),
 		Address:                types.StringValue(api.Address),
 		Notes:                  types.StringValue(api.Notes),
 		SLAUptime:              DecimalValue(api.UptimeSLA),
@@ -13,11 +13,13 @@ import (
 )
 
 func NewCheckSSHResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckSSHResourceModel, upapi.CheckSSH, upapi.Check](
+	return NewIdentityAPIResource[CheckSSHResourceModel, upapi.CheckSSH, upapi.Check](
 		CheckSSHResourceAPI{provider: p},
 		CheckSSHResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_ssh",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Monitor SSH access for a domain or IP address. Import using the check ID: `terraform import uptime_check_ssh.example 123`",
 				Attributes: map[string]schema.Attribute{
@@ -27,10 +29,13 @@ func NewCheckSSHResource(_ context.Context, p *providerImpl) resource.Resource {
 					"address":                   AddressHostnameSchemaAttribute(),
 					"port":                      RequiredPortSchemaAttribute(),
 					"sensitivity":               SensitivitySchemaAttribute(2),
-					"contact_groups":            ContactGroupsSchemaAttribute(),
+					"contact_groups":            ContactGroupsSchemaAttribute(p),
 					"locations":                 LocationsSchemaAttribute(p),
 					"tags":                      TagsSchemaAttribute(),
 					"is_paused":                 IsPausedSchemaAttribute(),
+					"on_destroy":                OnDestroySchemaAttribute(),
+					"escalations":               EscalationsSchemaAttribute(p),
+					"maintenance":               MaintenanceSchemaAttribute(p),
 					"interval":                  IntervalSchemaAttribute(5),
 					"num_retries":               NumRetriesAttribute(2),
 					"use_ip_version":            UseIPVersionSchemaAttribute(),
@@ -55,6 +60,9 @@ type CheckSSHResourceModel struct {
 	Locations              types.Set    `tfsdk:"locations"`
 	Tags                   types.Set    `tfsdk:"tags"`
 	IsPaused               types.Bool   `tfsdk:"is_paused"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	Escalations            types.List   `tfsdk:"escalations"`
+	Maintenance            types.Object `tfsdk:"maintenance"`
 	Interval               types.Int64  `tfsdk:"interval"`
 	NumRetries             types.Int64  `tfsdk:"num_retries"`
 	UseIpVersion           types.String `tfsdk:"use_ip_version"`
@@ -74,6 +82,7 @@ type CheckSSHResourceModelAdapter struct {
 	LocationsAttributeAdapter
 	TagsAttributeAdapter
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckSSHResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckSSHResourceModel, diag.Diagnostics) {
@@ -130,6 +139,8 @@ func (a CheckSSHResourceModelAdapter) FromAPIResult(api upapi.Check) (*CheckSSHR
 		Locations:              a.LocationsValue(api.Locations),
 		Tags:                   a.TagsValue(api.Tags),
 		IsPaused:               types.BoolValue(api.IsPaused),
+		Escalations:            a.EscalationsNullValue(),
+		Maintenance:            a.MaintenanceNullValue(),
 		Interval:               types.Int64Value(api.Interval),
 		NumRetries:             types.Int64Value(api.NumRetries),
 		UseIpVersion:           types.StringValue(api.UseIPVersion),
@@ -19,21 +19,26 @@ import (
 )
 
 func NewCheckSSLCertResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckSSLCertResourceModel, upapi.CheckSSLCert, upapi.Check](
+	return NewIdentityAPIResource[CheckSSLCertResourceModel, upapi.CheckSSLCert, upapi.Check](
 		CheckSSLCertResourceAPI{provider: p},
 		CheckSSLCertResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_sslcert",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Verify SSL certificate validity. Import using the check ID: `terraform import uptime_check_sslcert.example 123`",
 				Attributes: map[string]schema.Attribute{
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"locations":      LocationsOptionalSchemaAttribute(p),
 					"tags":           TagsSchemaAttribute(),
 					"is_paused":      IsPausedSchemaAttribute(),
+					"on_destroy":     OnDestroySchemaAttribute(),
+					"escalations":    EscalationsSchemaAttribute(p),
+					"maintenance":    MaintenanceSchemaAttribute(p),
 					"threshold": ThresholdDescriptionSchemaAttribute(
 						20,
 						"Raise an alert if there are less than this many days before the SSL certificate needs to be renewed",
@@ -142,6 +147,9 @@ type CheckSSLCertResourceModel struct {
 	Locations     types.Set    `tfsdk:"locations"`
 	Tags          types.Set    `tfsdk:"tags"`
 	IsPaused      types.Bool   `tfsdk:"is_paused"`
+	OnDestroy     types.String `tfsdk:"on_destroy"`
+	Escalations   types.List   `tfsdk:"escalations"`
+	Maintenance   types.Object `tfsdk:"maintenance"`
 	Address       types.String `tfsdk:"address"`
 	Port          types.Int64  `tfsdk:"port"`
 	Threshold     types.Int64  `tfsdk:"threshold"`
@@ -175,6 +183,7 @@ type CheckSSLCertResourceModelAdapter struct {
 	ContactGroupsAttributeAdapter
 	LocationsAttributeAdapter
 	TagsAttributeAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckSSLCertResourceModelAdapter) ConfigAttributeContext(ctx context.Context, v types.Object) (*CheckSSLCertConfigAttribute, diag.Diagnostics) {
@@ -281,6 +290,8 @@ func (a CheckSSLCertResourceModelAdapter) FromAPIResult(api upapi.Check) (*Check
 		Locations:     a.LocationsValue(api.Locations),
 		Tags:          a.TagsValue(api.Tags),
 		IsPaused:      types.BoolValue(api.IsPaused),
+		Escalations:   a.EscalationsNullValue(),
+		Maintenance:   a.MaintenanceNullValue(),
 		Address:       types.StringValue(api.Address),
 		Port:          types.Int64Value(api.Port),
 		Threshold:     types.Int64Value(api.Threshold),
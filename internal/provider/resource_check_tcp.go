@@ -13,11 +13,13 @@ import (
 )
 
 func NewCheckTCPResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckTCPResourceModel, upapi.CheckTCP, upapi.Check](
+	return NewIdentityAPIResource[CheckTCPResourceModel, upapi.CheckTCP, upapi.Check](
 		CheckTCPResourceAPI{provider: p},
 		CheckTCPResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_tcp",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Monitor a TCP port for a response. Import using the check ID: `terraform import uptime_check_tcp.example 123`",
 				Attributes: map[string]schema.Attribute{
@@ -28,10 +30,13 @@ func NewCheckTCPResource(_ context.Context, p *providerImpl) resource.Resource {
 					"port":                      RequiredPortSchemaAttribute(),
 					"send_string":               StringToSendSchemaAttribute(),
 					"expect_string":             StringToExpectSchemaAttribute(),
-					"contact_groups":            ContactGroupsSchemaAttribute(),
+					"contact_groups":            ContactGroupsSchemaAttribute(p),
 					"locations":                 LocationsSchemaAttribute(p),
 					"tags":                      TagsSchemaAttribute(),
 					"is_paused":                 IsPausedSchemaAttribute(),
+					"on_destroy":                OnDestroySchemaAttribute(),
+					"escalations":               EscalationsSchemaAttribute(p),
+					"maintenance":               MaintenanceSchemaAttribute(p),
 					"interval":                  IntervalSchemaAttribute(5),
 					"num_retries":               NumRetriesAttribute(2),
 					"use_ip_version":            UseIPVersionSchemaAttribute(),
@@ -70,6 +75,9 @@ type CheckTCPResourceModel struct {
 	Locations              types.Set    `tfsdk:"locations"`
 	Tags                   types.Set    `tfsdk:"tags"`
 	IsPaused               types.Bool   `tfsdk:"is_paused"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	Escalations            types.List   `tfsdk:"escalations"`
+	Maintenance            types.Object `tfsdk:"maintenance"`
 	Interval               types.Int64  `tfsdk:"interval"`
 	NumRetries             types.Int64  `tfsdk:"num_retries"`
 	UseIpVersion           types.String `tfsdk:"use_ip_version"`
@@ -90,6 +98,7 @@ type CheckTCPResourceModelAdapter struct {
 	LocationsAttributeAdapter
 	TagsAttributeAdapter
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckTCPResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckTCPResourceModel, diag.Diagnostics) {
@@ -149,6 +158,8 @@ func (a CheckTCPResourceModelAdapter) FromAPIResult(api upapi.Check) (*CheckTCPR
 		Locations:              a.LocationsValue(api.Locations),
 		Tags:                   a.TagsValue(api.Tags),
 		IsPaused:               types.BoolValue(api.IsPaused),
+		Escalations:            a.EscalationsNullValue(),
+		Maintenance:            a.MaintenanceNullValue(),
 		Interval:               types.Int64Value(api.Interval),
 		NumRetries:             types.Int64Value(api.NumRetries),
 		UseIpVersion:           types.StringValue(api.UseIPVersion),
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	petname "github.com/dustinkirkland/golang-petname"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccCheckResource(t *testing.T) {
+	names := [2]string{
+		petname.Generate(3, "-"),
+		petname.Generate(3, "-"),
+	}
+	resource.Test(t, testCaseFromSteps(t, []resource.TestStep{
+		{
+			ConfigVariables: config.Variables{
+				"name":    config.StringVariable(names[0]),
+				"address": config.StringVariable("example.com"),
+			},
+			ConfigDirectory: config.StaticDirectory("testdata/resource_check/_basic"),
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttrSet("uptime_check.test", "id"),
+				resource.TestCheckResourceAttrPair("uptime_check.test", "id", "uptime_check.test", "dns.id"),
+				resource.TestCheckResourceAttr("uptime_check.test", "type", "DNS"),
+				resource.TestCheckResourceAttr("uptime_check.test", "dns.name", names[0]),
+				resource.TestCheckResourceAttr("uptime_check.test", "dns.address", "example.com"),
+				resource.TestCheckNoResourceAttr("uptime_check.test", "http"),
+			),
+		},
+		{
+			ConfigVariables: config.Variables{
+				"name":    config.StringVariable(names[1]),
+				"address": config.StringVariable("example.net"),
+			},
+			ConfigDirectory: config.StaticDirectory("testdata/resource_check/_basic"),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction("uptime_check.test", plancheck.ResourceActionUpdate),
+				},
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("uptime_check.test", "dns.name", names[1]),
+				resource.TestCheckResourceAttr("uptime_check.test", "dns.address", "example.net"),
+			),
+		},
+	}))
+}
+
+func TestAccCheckResource_MovedFromTyped(t *testing.T) {
+	name := petname.Generate(3, "-")
+	resource.Test(t, testCaseFromSteps(t, []resource.TestStep{
+		{
+			ConfigVariables: config.Variables{
+				"name": config.StringVariable(name),
+			},
+			ConfigDirectory: config.StaticDirectory("testdata/resource_check/moved_from"),
+		},
+		{
+			ConfigVariables: config.Variables{
+				"name": config.StringVariable(name),
+			},
+			ConfigDirectory: config.StaticDirectory("testdata/resource_check/moved_to"),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectEmptyPlan(),
+				},
+			},
+			Check: resource.ComposeAggregateTestCheckFunc(
+				resource.TestCheckResourceAttr("uptime_check.test", "type", "DNS"),
+				resource.TestCheckResourceAttr("uptime_check.test", "dns.name", name),
+				resource.TestCheckResourceAttr("uptime_check.test", "dns.interval", "10"),
+			),
+		},
+	}))
+}
+
+func testCheckResource(t *testing.T) *CheckResource {
+	t.Helper()
+	r, ok := NewCheckResource(context.Background(), &providerImpl{}).(*CheckResource)
+	if !ok {
+		t.Fatal("NewCheckResource did not return a *CheckResource")
+	}
+	return r
+}
+
+func TestCheckResourceMoveState(t *testing.T) {
+	ctx := context.Background()
+	r := testCheckResource(t)
+	typed := r.typed["DNS"]
+
+	source := typed.nullState(ctx)
+	for name, v := range map[string]any{"id": int64(123), "name": "test", "address": "example.com"} {
+		if diags := source.SetAttribute(ctx, path.Root(name), v); diags.HasError() {
+			t.Fatalf("set %s: %v", name, diags)
+		}
+	}
+
+	move := func(typeName string) fwresource.MoveStateResponse {
+		rs := fwresource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: r.schema,
+				Raw:    tftypes.NewValue(r.schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		for _, mover := range r.MoveState(ctx) {
+			mover.StateMover(ctx, fwresource.MoveStateRequest{
+				SourceProviderAddress: "registry.terraform.io/uptime-com/uptime",
+				SourceTypeName:        typeName,
+				SourceState:           &source,
+			}, &rs)
+			if rs.Diagnostics.HasError() || !rs.TargetState.Raw.IsNull() {
+				break
+			}
+		}
+		return rs
+	}
+
+	rs := move("uptime_check_dns")
+	if rs.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", rs.Diagnostics)
+	}
+	var (
+		checkType, name types.String
+		id, nestedID    types.Int64
+		http            types.Object
+	)
+	rs.TargetState.GetAttribute(ctx, path.Root("type"), &checkType)
+	rs.TargetState.GetAttribute(ctx, path.Root("id"), &id)
+	rs.TargetState.GetAttribute(ctx, path.Root("dns").AtName("id"), &nestedID)
+	rs.TargetState.GetAttribute(ctx, path.Root("dns").AtName("name"), &name)
+	rs.TargetState.GetAttribute(ctx, path.Root("http"), &http)
+	if checkType.ValueString() != "DNS" {
+		t.Errorf("type = %v, want DNS", checkType)
+	}
+	if id.ValueInt64() != 123 || nestedID.ValueInt64() != 123 {
+		t.Errorf("id = %v, dns.id = %v, want 123", id, nestedID)
+	}
+	if name.ValueString() != "test" {
+		t.Errorf("dns.name = %v, want test", name)
+	}
+	if !http.IsNull() {
+		t.Errorf("http = %v, want null", http)
+	}
+
+	// Other resource types are left to the framework to reject.
+	if rs := move("uptime_tag"); rs.Diagnostics.HasError() || !rs.TargetState.Raw.IsNull() {
+		t.Errorf("uptime_tag was moved: %v", rs.Diagnostics)
+	}
+}
+
+func TestCheckResourceImportState(t *testing.T) {
+	ctx := context.Background()
+	r := testCheckResource(t)
+	cases := map[string]struct {
+		id      string
+		wantErr bool
+	}{
+		"type and id":  {"HTTP:123", false},
+		"missing type": {"123", true},
+		"unknown type": {"FTP:123", true},
+		"invalid id":   {"HTTP:abc", true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			rs := fwresource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: r.schema,
+					Raw:    tftypes.NewValue(r.schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.ImportState(ctx, fwresource.ImportStateRequest{ID: tc.id}, &rs)
+			if rs.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("HasError() = %v, want %v: %v", rs.Diagnostics.HasError(), tc.wantErr, rs.Diagnostics)
+			}
+			if tc.wantErr {
+				return
+			}
+			var nestedID types.Int64
+			rs.State.GetAttribute(ctx, path.Root("http").AtName("id"), &nestedID)
+			if nestedID.ValueInt64() != 123 {
+				t.Errorf("http.id = %v, want 123", nestedID)
+			}
+		})
+	}
+}
+
+func TestCheckNestedDiagnostics(t *testing.T) {
+	var in diag.Diagnostics
+	in.AddAttributeError(path.Root("headers").AtMapKey("Accept"), "Invalid Header", "detail")
+	in.AddError("Client Error", "detail")
+
+	out := checkNestedDiagnostics("http", in)
+	if len(out) != 2 {
+		t.Fatalf("got %d diagnostics, want 2", len(out))
+	}
+	withPath, ok := out[0].(diag.DiagnosticWithPath)
+	if !ok {
+		t.Fatalf("first diagnostic lost its path: %v", out[0])
+	}
+	if want := path.Root("http").AtName("headers").AtMapKey("Accept"); !withPath.Path().Equal(want) {
+		t.Errorf("path = %s, want %s", withPath.Path(), want)
+	}
+	if _, ok := out[1].(diag.DiagnosticWithPath); ok {
+		t.Errorf("second diagnostic gained a path: %v", out[1])
+	}
+}
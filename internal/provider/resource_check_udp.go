@@ -13,11 +13,13 @@ import (
 )
 
 func NewCheckUDPResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckUDPResourceModel, upapi.CheckUDP, upapi.Check](
+	return NewIdentityAPIResource[CheckUDPResourceModel, upapi.CheckUDP, upapi.Check](
 		CheckUDPResourceAPI{provider: p},
 		CheckUDPResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_udp",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Monitor a UDP port for a response. Import using the check ID: `terraform import uptime_check_udp.example 123`",
 				Attributes: map[string]schema.Attribute{
@@ -35,10 +37,13 @@ func NewCheckUDPResource(_ context.Context, p *providerImpl) resource.Resource {
 						Required:    true,
 					},
 					"sensitivity":               SensitivitySchemaAttribute(2),
-					"contact_groups":            ContactGroupsSchemaAttribute(),
+					"contact_groups":            ContactGroupsSchemaAttribute(p),
 					"locations":                 LocationsSchemaAttribute(p),
 					"tags":                      TagsSchemaAttribute(),
 					"is_paused":                 IsPausedSchemaAttribute(),
+					"on_destroy":                OnDestroySchemaAttribute(),
+					"escalations":               EscalationsSchemaAttribute(p),
+					"maintenance":               MaintenanceSchemaAttribute(p),
 					"interval":                  IntervalSchemaAttribute(5),
 					"num_retries":               NumRetriesAttribute(2),
 					"use_ip_version":            UseIPVersionSchemaAttribute(),
@@ -65,6 +70,9 @@ type CheckUDPResourceModel struct {
 	Locations              types.Set    `tfsdk:"locations"`
 	Tags                   types.Set    `tfsdk:"tags"`
 	IsPaused               types.Bool   `tfsdk:"is_paused"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	Escalations            types.List   `tfsdk:"escalations"`
+	Maintenance            types.Object `tfsdk:"maintenance"`
 	Interval               types.Int64  `tfsdk:"interval"`
 	NumRetries             types.Int64  `tfsdk:"num_retries"`
 	UseIpVersion           types.String `tfsdk:"use_ip_version"`
@@ -84,6 +92,7 @@ type CheckUDPResourceModelAdapter struct {
 	LocationsAttributeAdapter
 	TagsAttributeAdapter
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckUDPResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckUDPResourceModel, diag.Diagnostics) {
@@ -144,6 +153,8 @@ func (a CheckUDPResourceModelAdapter) FromAPIResult(api upapi.Check) (*CheckUDPR
 		Locations:              a.LocationsValue(api.Locations),
 		Tags:                   a.TagsValue(api.Tags),
 		IsPaused:               types.BoolValue(api.IsPaused),
+		Escalations:            a.EscalationsNullValue(),
+		Maintenance:            a.MaintenanceNullValue(),
 		Interval:               types.Int64Value(api.Interval),
 		NumRetries:             types.Int64Value(api.NumRetries),
 		UseIpVersion:           types.StringValue(api.UseIPVersion),
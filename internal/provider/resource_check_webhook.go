@@ -12,20 +12,25 @@ import (
 )
 
 func NewCheckWebhookResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckWebhookResourceModel, upapi.CheckWebhook, upapi.Check](
+	return NewIdentityAPIResource[CheckWebhookResourceModel, upapi.CheckWebhook, upapi.Check](
 		CheckWebookResourceAPI{provider: p},
 		CheckWebhookResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_webhook",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Receive alerts based on periodic jobs or processes using an automated HTTP callback. Import using the check ID: `terraform import uptime_check_webhook.example 123`",
 				Attributes: map[string]schema.Attribute{
 					"id":                        IDSchemaAttribute(),
 					"url":                       URLSchemaAttribute(),
 					"name":                      NameSchemaAttribute(),
-					"contact_groups":            ContactGroupsSchemaAttribute(),
+					"contact_groups":            ContactGroupsSchemaAttribute(p),
 					"tags":                      TagsSchemaAttribute(),
 					"is_paused":                 IsPausedSchemaAttribute(),
+					"on_destroy":                OnDestroySchemaAttribute(),
+					"escalations":               EscalationsSchemaAttribute(p),
+					"maintenance":               MaintenanceSchemaAttribute(p),
 					"notes":                     NotesSchemaAttribute(),
 					"sla":                       SLASchemaAttribute(),
 					"include_in_global_metrics": IncludeInGlobalMetricsSchemaAttribute(),
@@ -47,6 +52,9 @@ type CheckWebhookResourceModel struct {
 	ContactGroups          types.Set    `tfsdk:"contact_groups"`
 	Tags                   types.Set    `tfsdk:"tags"`
 	IsPaused               types.Bool   `tfsdk:"is_paused"`
+	OnDestroy              types.String `tfsdk:"on_destroy"`
+	Escalations            types.List   `tfsdk:"escalations"`
+	Maintenance            types.Object `tfsdk:"maintenance"`
 	Notes                  types.String `tfsdk:"notes"`
 	SLA                    types.Object `tfsdk:"sla"`
 	IncludeInGlobalMetrics types.Bool   `tfsdk:"include_in_global_metrics"`
@@ -63,6 +71,7 @@ type CheckWebhookResourceModelAdapter struct {
 	ContactGroupsAttributeAdapter
 	TagsAttributeAdapter
 	SLAAttributeContextAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckWebhookResourceModelAdapter) Get(ctx context.Context, sg StateGetter) (*CheckWebhookResourceModel, diag.Diagnostics) {
@@ -108,6 +117,8 @@ func (a CheckWebhookResourceModelAdapter) FromAPIResult(api upapi.Check) (*Check
 		ContactGroups:          a.ContactGroupsValue(api.ContactGroups),
 		Tags:                   a.TagsValue(api.Tags),
 		IsPaused:               types.BoolValue(api.IsPaused),
+		Escalations:            a.EscalationsNullValue(),
+		Maintenance:            a.MaintenanceNullValue(),
 		Notes:                  types.StringValue(api.Notes),
 		IncludeInGlobalMetrics: types.BoolValue(api.IncludeInGlobalMetrics),
 		WebhookURL:             types.StringValue(api.WebhookURL),
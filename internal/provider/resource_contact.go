@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -13,7 +14,7 @@ import (
 )
 
 func NewContactResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[ContactResourceModel, upapi.Contact, upapi.Contact](
+	return NewIdentityAPIResource[ContactResourceModel, upapi.Contact, upapi.Contact](
 		&ContactResourceAPI{provider: p},
 		ContactResourceModelAdapter{},
 		APIResourceMetadata{
@@ -21,9 +22,10 @@ func NewContactResource(_ context.Context, p *providerImpl) resource.Resource {
 			Schema: schema.Schema{
 				Description: "Contact resource. Import using the contact ID: `terraform import uptime_contact.example 123`",
 				Attributes: map[string]schema.Attribute{
-					"id":   IDSchemaAttribute(),
-					"url":  URLSchemaAttribute(),
-					"name": NameSchemaAttribute(),
+					"id":             IDSchemaAttribute(),
+					"url":            URLSchemaAttribute(),
+					"name":           NameSchemaAttribute(),
+					"adopt_existing": AdoptExistingSchemaAttribute("name"),
 					"sms_list": schema.SetAttribute{
 						ElementType: types.StringType,
 						Computed:    true,
@@ -84,6 +86,8 @@ type ContactResourceModel struct {
 	PhonecallList            types.Set    `tfsdk:"phonecall_list"`
 	Integrations             types.Set    `tfsdk:"integrations"`
 	PushNotificationProfiles types.Set    `tfsdk:"push_notification_profiles"`
+
+	AdoptExisting types.Bool `tfsdk:"adopt_existing"`
 }
 
 func (m ContactResourceModel) PrimaryKey() upapi.PrimaryKey {
@@ -151,3 +155,37 @@ func (c ContactResourceAPI) Update(ctx context.Context, pk upapi.PrimaryKeyable,
 func (c ContactResourceAPI) Delete(ctx context.Context, pk upapi.PrimaryKeyable) error {
 	return c.provider.api.Contacts().Delete(ctx, pk)
 }
+
+func (c ContactResourceAPI) Find(ctx context.Context, arg upapi.Contact) (upapi.PrimaryKeyable, error) {
+	return c.provider.FindContact(ctx, arg.Name)
+}
+
+// FindContact looks a contact up by its exact name, paging through all
+// contacts. It returns nil if no such contact exists and an error if the name
+// is ambiguous.
+func (p *providerImpl) FindContact(ctx context.Context, name string) (upapi.PrimaryKeyable, error) {
+	var found []upapi.PrimaryKeyable
+	err := ListEach(ctx, listPageSize, func(ctx context.Context, page, pageSize int64) ([]upapi.Contact, int64, error) {
+		api, err := p.api.Contacts().List(ctx, upapi.ContactListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	}, func(item upapi.Contact) bool {
+		if item.Name == name {
+			found = append(found, upapi.PrimaryKey(item.PK))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch len(found) {
+	case 0:
+		return nil, nil
+	case 1:
+		return found[0], nil
+	default:
+		return nil, fmt.Errorf("%d contacts are named %q, cannot pick one to adopt", len(found), name)
+	}
+}
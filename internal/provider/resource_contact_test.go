@@ -6,6 +6,9 @@ import (
 	petname "github.com/dustinkirkland/golang-petname"
 	"github.com/hashicorp/terraform-plugin-testing/config"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccContactResource_EmailList(t *testing.T) {
@@ -46,6 +49,35 @@ func TestAccContactResource_EmailList(t *testing.T) {
 	}))
 }
 
+func TestAccContactResource_Identity(t *testing.T) {
+	vars := config.Variables{
+		"name": config.StringVariable(petname.Generate(3, "-")),
+		"email_list": config.ListVariable(
+			config.StringVariable("nobody@uptime.com"),
+		),
+	}
+	tc := testCaseFromSteps(t, []resource.TestStep{
+		{
+			ConfigDirectory: config.StaticDirectory("testdata/resource_contact/email_list"),
+			ConfigVariables: vars,
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectIdentityValueMatchesState("uptime_contact.test", tfjsonpath.New("id")),
+			},
+		},
+		{
+			ConfigDirectory: config.StaticDirectory("testdata/resource_contact/email_list"),
+			ConfigVariables: vars,
+			ResourceName:    "uptime_contact.test",
+			ImportState:     true,
+			ImportStateKind: resource.ImportBlockWithResourceIdentity,
+		},
+	})
+	tc.TerraformVersionChecks = []tfversion.TerraformVersionCheck{
+		tfversion.SkipBelow(tfversion.Version1_12_0),
+	}
+	resource.Test(t, tc)
+}
+
 func TestAccContactResource_PhonecallList(t *testing.T) {
 	names := [2]string{
 		petname.Generate(3, "-"),
@@ -23,7 +23,7 @@ func NewIntegrationCachetResource(_ context.Context, p *providerImpl) resource.R
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"cachet_url": schema.StringAttribute{
 						Required:    true,
 						Description: "The URL of your Cachet instance",
@@ -22,7 +22,7 @@ func NewIntegrationGeckoboardResource(_ context.Context, p *providerImpl) resour
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"api_key": schema.StringAttribute{
 						Required:    true,
 						Sensitive:   true,
@@ -24,7 +24,7 @@ func NewIntegrationJiraServicedeskResource(_ context.Context, p *providerImpl) r
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"api_email": schema.StringAttribute{
 						Required:    true,
 						Description: "Email address for JIRA API authentication",
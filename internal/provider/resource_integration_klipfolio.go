@@ -22,7 +22,7 @@ func NewIntegrationKlipfolioResource(_ context.Context, p *providerImpl) resourc
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"api_key": schema.StringAttribute{
 						Required:    true,
 						Sensitive:   true,
@@ -24,7 +24,7 @@ func NewIntegrationOpsgenieResource(_ context.Context, p *providerImpl) resource
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"api_endpoint": schema.StringAttribute{
 						Required: true,
 					},
@@ -23,7 +23,7 @@ func NewIntegrationPagerdutyResource(_ context.Context, p *providerImpl) resourc
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"service_key": schema.StringAttribute{
 						Required:    true,
 						Sensitive:   true,
@@ -22,7 +22,7 @@ func NewIntegrationPushbulletResource(_ context.Context, p *providerImpl) resour
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"email": schema.StringAttribute{
 						Required:    true,
 						Sensitive:   true,
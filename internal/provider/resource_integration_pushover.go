@@ -22,7 +22,7 @@ func NewIntegrationPushoverResource(_ context.Context, p *providerImpl) resource
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"user": schema.StringAttribute{
 						Required:    true,
 						Sensitive:   true,
@@ -23,7 +23,7 @@ func NewIntegrationSlackResource(_ context.Context, p *providerImpl) resource.Re
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"webhook_url": schema.StringAttribute{
 						Required:    true,
 						Sensitive:   true,
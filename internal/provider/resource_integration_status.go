@@ -23,7 +23,7 @@ func NewIntegrationStatusResource(_ context.Context, p *providerImpl) resource.R
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"statuspage_id": schema.StringAttribute{
 						Required:    true,
 						Description: "Status.io status page ID",
@@ -23,7 +23,7 @@ func NewIntegrationStatuspageResource(_ context.Context, p *providerImpl) resour
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"api_key": schema.StringAttribute{
 						Required:    true,
 						Sensitive:   true,
@@ -22,7 +22,7 @@ func NewIntegrationWavefrontResource(_ context.Context, p *providerImpl) resourc
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"wavefront_url": schema.StringAttribute{
 						Required:    true,
 						Sensitive:   true,
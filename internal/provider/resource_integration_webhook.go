@@ -24,7 +24,7 @@ func NewIntegrationWebhookResource(_ context.Context, p *providerImpl) resource.
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"postback_url": schema.StringAttribute{
 						Required:    true,
 						Sensitive:   true,
@@ -22,7 +22,7 @@ func NewIntegrationZapierResource(_ context.Context, p *providerImpl) resource.R
 					"id":             IDSchemaAttribute(),
 					"url":            URLSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"webhook_url": schema.StringAttribute{
 						Required:    true,
 						Sensitive:   true,
@@ -15,20 +15,25 @@ import (
 )
 
 func NewCheckPageSpeedResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[CheckPageSpeedResourceModel, upapi.CheckPageSpeed, upapi.Check](
+	return NewIdentityAPIResource[CheckPageSpeedResourceModel, upapi.CheckPageSpeed, upapi.Check](
 		CheckPageSpeedResourceAPI{provider: p},
 		CheckPageSpeedResourceModelAdapter{},
 		APIResourceMetadata{
 			TypeNameSuffix: "check_pagespeed",
+			OnDestroy:      CheckOnDestroyHandler(p),
+			Inline:         CheckInlineAttributes(p),
 			Schema: schema.Schema{
 				Description: "Page Speed Check. Import using the check ID: `terraform import uptime_check_pagespeed.example 123`",
 				Attributes: map[string]schema.Attribute{
 					"id":             IDSchemaAttribute(),
 					"name":           NameSchemaAttribute(),
-					"contact_groups": ContactGroupsSchemaAttribute(),
+					"contact_groups": ContactGroupsSchemaAttribute(p),
 					"locations":      LocationsSchemaAttribute(p),
 					"tags":           TagsSchemaAttribute(),
 					"is_paused":      IsPausedSchemaAttribute(),
+					"on_destroy":     OnDestroySchemaAttribute(),
+					"escalations":    EscalationsSchemaAttribute(p),
+					"maintenance":    MaintenanceSchemaAttribute(p),
 					"interval":       IntervalSchemaAttribute(1440),
 					"username": schema.StringAttribute{
 						Optional: true,
@@ -90,6 +95,9 @@ type CheckPageSpeedResourceModel struct {
 	Locations     types.Set    `tfsdk:"locations"`
 	Tags          types.Set    `tfsdk:"tags"`
 	IsPaused      types.Bool   `tfsdk:"is_paused"`
+	OnDestroy     types.String `tfsdk:"on_destroy"`
+	Escalations   types.List   `tfsdk:"escalations"`
+	Maintenance   types.Object `tfsdk:"maintenance"`
 	Interval      types.Int64  `tfsdk:"interval"`
 	Username      types.String `tfsdk:"username"`
 	Password      types.String `tfsdk:"password"`
@@ -117,6 +125,7 @@ type CheckPageSpeedResourceModelAdapter struct {
 	ContactGroupsAttributeAdapter
 	LocationsAttributeAdapter
 	TagsAttributeAdapter
+	CheckInlineAttributesAdapter
 }
 
 func (a CheckPageSpeedResourceModelAdapter) ConfigAttributeContext(ctx context.Context, v types.Object) (*CheckPageSpeedConfigAttribute, diag.Diagnostics) {
@@ -201,6 +210,8 @@ func (a CheckPageSpeedResourceModelAdapter) FromAPIResult(api upapi.Check) (*Che
 		Locations:     a.LocationsValue(api.Locations),
 		Tags:          a.TagsValue(api.Tags),
 		IsPaused:      types.BoolValue(api.IsPaused),
+		Escalations:   a.EscalationsNullValue(),
+		Maintenance:   a.MaintenanceNullValue(),
 		Interval:      types.Int64Value(api.Interval),
 		Username:      types.StringValue(api.Username),
 		Password:      types.StringValue(api.Password),
@@ -15,7 +15,7 @@ import (
 )
 
 func NewStatusPageResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[StatusPageResourceModel, upapi.StatusPage, upapi.StatusPage](
+	return NewIdentityAPIResource[StatusPageResourceModel, upapi.StatusPage, upapi.StatusPage](
 		&StatusPageResourceAPI{provider: p},
 		StatusPageResourceModelAdapter{},
 		APIResourceMetadata{
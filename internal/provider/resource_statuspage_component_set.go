@@ -0,0 +1,598 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func NewStatusPageComponentSetResource(_ context.Context, p *providerImpl) resource.Resource {
+	api := &StatusPageComponentSetResourceAPI{provider: p}
+	return APIResource[StatusPageComponentSetResourceModel, StatusPageComponentSet, StatusPageComponentSet]{
+		api: api,
+		mod: StatusPageComponentSetResourceModelAdapter{},
+		meta: APIResourceMetadata{
+			TypeNameSuffix: "statuspage_component_set",
+			Schema: schema.Schema{
+				Description: "Status page components created from checks. The set keeps one component linked to " +
+					"every check with one of `tags`, or in the check group `check_group_id`: the plan adds " +
+					"components for new checks, renames components of renamed checks and removes components of " +
+					"checks that no longer match. Checks created in the same apply as an existing set are picked up " +
+					"by the next plan. Each plan lists the checks with the selected tags; check groups naming " +
+					"their checks by name rather than ID list all checks of the account. Components are ordered by " +
+					"check name; use `uptime_statuspage_layout` to arrange them.",
+				Attributes: map[string]schema.Attribute{
+					"statuspage_id": schema.Int64Attribute{
+						Required: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+					"tags": schema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Create a component for every check with any of these tags",
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+					"check_group_id": schema.Int64Attribute{
+						Optional:    true,
+						Description: "Create a component for every check in this check group",
+					},
+					"name_template": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("{name}"),
+						Description: "Name of the components. `{name}` is replaced with the name of the check and " +
+							"`{id}` with its ID",
+					},
+					"auto_status_down": schema.StringAttribute{
+						Optional:    true,
+						Description: "Status the components are set to when their check goes down",
+						Validators: []validator.String{
+							OneOfStringValidator([]string{"major-outage", "partial-outage", "degraded-performance", "under-maintenance"}),
+						},
+					},
+					"auto_status_up": schema.StringAttribute{
+						Optional:    true,
+						Description: "Status the components are set to when their check comes back up",
+						Validators: []validator.String{
+							OneOfStringValidator([]string{"operational", "major-outage", "partial-outage", "degraded-performance", "under-maintenance"}),
+						},
+					},
+					"components": schema.ListNestedAttribute{
+						Computed:    true,
+						Description: "Components of the set, ordered by check name",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"check_id": schema.Int64Attribute{
+									Computed:    true,
+									Description: "ID of the check the component shows",
+								},
+								"component_id": schema.Int64Attribute{
+									Computed: true,
+								},
+								"name": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
+						PlanModifiers: []planmodifier.List{
+							statusPageComponentSetPlanModifier{api: api},
+						},
+					},
+				},
+			},
+			ConfigValidators: func(context.Context) []resource.ConfigValidator {
+				return []resource.ConfigValidator{
+					resourcevalidator.ExactlyOneOf(path.MatchRoot("tags"), path.MatchRoot("check_group_id")),
+				}
+			},
+		},
+	}
+}
+
+// StatusPageComponentSet is the selector of a component set and its
+// components.
+type StatusPageComponentSet struct {
+	StatusPageID   int64
+	Tags           []string
+	CheckGroupID   int64
+	NameTemplate   string
+	AutoStatusDown string
+	AutoStatusUp   string
+	// Components are the components of the set. Unless Planned, they were not
+	// known at plan time and are computed when the set is applied.
+	Components []StatusPageComponentSetEntry
+	Planned    bool
+}
+
+func (s StatusPageComponentSet) PrimaryKey() upapi.PrimaryKey {
+	return upapi.PrimaryKey(s.StatusPageID)
+}
+
+// StatusPageComponentSetEntry is a component of a set. ComponentID is zero for
+// components yet to be created.
+type StatusPageComponentSetEntry struct {
+	CheckID     int64
+	ComponentID int64
+	Name        string
+}
+
+// componentName expands the name template for check.
+func (s StatusPageComponentSet) componentName(check upapi.Check) string {
+	return strings.NewReplacer("{name}", check.Name, "{id}", strconv.FormatInt(check.PK, 10)).Replace(s.NameTemplate)
+}
+
+// selector returns the services and tags checks are selected by. group is the
+// check group of CheckGroupID, if set.
+func (s StatusPageComponentSet) selector(group *upapi.Check) (services, tags []string) {
+	if group != nil && group.GroupConfig != nil {
+		return group.GroupConfig.CheckServices, group.GroupConfig.CheckTags
+	}
+	return nil, s.Tags
+}
+
+// selectChecks returns the checks matching services or tags, as returned by
+// selector, ordered by name, then ID. Services of a check group are matched
+// by check ID or name. Group checks are never selected.
+func selectChecks(checks []upapi.Check, services, tags []string) []upapi.Check {
+	var out []upapi.Check
+	for _, c := range checks {
+		if c.GroupConfig != nil {
+			continue
+		}
+		if slices.Contains(services, strconv.FormatInt(c.PK, 10)) || slices.Contains(services, c.Name) ||
+			slices.ContainsFunc(c.Tags, func(t string) bool {
+				return slices.Contains(tags, t)
+			}) {
+			out = append(out, c)
+		}
+	}
+	slices.SortFunc(out, func(a, b upapi.Check) int {
+		return cmp.Or(cmp.Compare(a.Name, b.Name), cmp.Compare(a.PK, b.PK))
+	})
+	return out
+}
+
+type StatusPageComponentSetResourceModel struct {
+	StatusPageID   types.Int64  `tfsdk:"statuspage_id"`
+	Tags           types.Set    `tfsdk:"tags"`
+	CheckGroupID   types.Int64  `tfsdk:"check_group_id"`
+	NameTemplate   types.String `tfsdk:"name_template"`
+	AutoStatusDown types.String `tfsdk:"auto_status_down"`
+	AutoStatusUp   types.String `tfsdk:"auto_status_up"`
+	Components     types.List   `tfsdk:"components"`
+
+	components []StatusPageComponentSetEntryAttribute
+}
+
+func (m StatusPageComponentSetResourceModel) PrimaryKey() upapi.PrimaryKey {
+	return upapi.PrimaryKey(m.StatusPageID.ValueInt64())
+}
+
+type StatusPageComponentSetEntryAttribute struct {
+	CheckID     types.Int64  `tfsdk:"check_id"`
+	ComponentID types.Int64  `tfsdk:"component_id"`
+	Name        types.String `tfsdk:"name"`
+}
+
+type StatusPageComponentSetResourceModelAdapter struct {
+	SetAttributeAdapter[string]
+}
+
+func (a StatusPageComponentSetResourceModelAdapter) entryAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"check_id":     types.Int64Type,
+		"component_id": types.Int64Type,
+		"name":         types.StringType,
+	}
+}
+
+func (a StatusPageComponentSetResourceModelAdapter) Get(
+	ctx context.Context, sg StateGetter,
+) (*StatusPageComponentSetResourceModel, diag.Diagnostics) {
+	var model StatusPageComponentSetResourceModel
+	if diags := sg.Get(ctx, &model); diags.HasError() {
+		return nil, diags
+	}
+	if !model.Components.IsNull() && !model.Components.IsUnknown() {
+		if diags := model.Components.ElementsAs(ctx, &model.components, false); diags.HasError() {
+			return nil, diags
+		}
+	}
+	return &model, nil
+}
+
+func (a StatusPageComponentSetResourceModelAdapter) ToAPIArgument(
+	model StatusPageComponentSetResourceModel,
+) (*StatusPageComponentSet, error) {
+	api := StatusPageComponentSet{
+		StatusPageID:   model.StatusPageID.ValueInt64(),
+		Tags:           a.Slice(model.Tags),
+		CheckGroupID:   model.CheckGroupID.ValueInt64(),
+		NameTemplate:   model.NameTemplate.ValueString(),
+		AutoStatusDown: model.AutoStatusDown.ValueString(),
+		AutoStatusUp:   model.AutoStatusUp.ValueString(),
+		Planned:        !model.Components.IsUnknown(),
+	}
+	for _, v := range model.components {
+		api.Components = append(api.Components, StatusPageComponentSetEntry{
+			CheckID:     v.CheckID.ValueInt64(),
+			ComponentID: v.ComponentID.ValueInt64(),
+			Name:        v.Name.ValueString(),
+		})
+	}
+	return &api, nil
+}
+
+func (a StatusPageComponentSetResourceModelAdapter) FromAPIResult(
+	api StatusPageComponentSet,
+) (*StatusPageComponentSetResourceModel, error) {
+	model := &StatusPageComponentSetResourceModel{
+		StatusPageID:   types.Int64Value(api.StatusPageID),
+		Tags:           types.SetNull(types.StringType),
+		CheckGroupID:   types.Int64Null(),
+		NameTemplate:   types.StringValue(api.NameTemplate),
+		AutoStatusDown: types.StringNull(),
+		AutoStatusUp:   types.StringNull(),
+	}
+	if len(api.Tags) != 0 {
+		model.Tags = a.SliceValue(api.Tags)
+	}
+	if api.CheckGroupID != 0 {
+		model.CheckGroupID = types.Int64Value(api.CheckGroupID)
+	}
+	if api.AutoStatusDown != "" {
+		model.AutoStatusDown = types.StringValue(api.AutoStatusDown)
+	}
+	if api.AutoStatusUp != "" {
+		model.AutoStatusUp = types.StringValue(api.AutoStatusUp)
+	}
+	var err error
+	if model.Components, err = a.componentsValue(api.Components); err != nil {
+		return nil, err
+	}
+	return model, nil
+}
+
+// componentsValue converts entries to the components attribute. The IDs of
+// components yet to be created are unknown.
+func (a StatusPageComponentSetResourceModelAdapter) componentsValue(entries []StatusPageComponentSetEntry) (types.List, error) {
+	values := make([]attr.Value, len(entries))
+	for i, e := range entries {
+		componentID := types.Int64Unknown()
+		if e.ComponentID != 0 {
+			componentID = types.Int64Value(e.ComponentID)
+		}
+		v, d := types.ObjectValue(a.entryAttributeTypes(), map[string]attr.Value{
+			"check_id":     types.Int64Value(e.CheckID),
+			"component_id": componentID,
+			"name":         types.StringValue(e.Name),
+		})
+		if d.HasError() {
+			return types.List{}, fmt.Errorf("failed to convert component of check %d: %v", e.CheckID, d)
+		}
+		values[i] = v
+	}
+	v, d := types.ListValue(types.ObjectType{AttrTypes: a.entryAttributeTypes()}, values)
+	if d.HasError() {
+		return types.List{}, fmt.Errorf("failed to convert components: %v", d)
+	}
+	return v, nil
+}
+
+type StatusPageComponentSetResourceAPI struct {
+	provider *providerImpl
+}
+
+func (a StatusPageComponentSetResourceAPI) statusPageComponents(
+	ctx context.Context, statusPageID int64,
+) (map[int64]upapi.StatusPageComponent, error) {
+	components := make(map[int64]upapi.StatusPageComponent)
+	err := ListEach(ctx, listPageSize, func(ctx context.Context, page, pageSize int64) ([]upapi.StatusPageComponent, int64, error) {
+		api, err := a.provider.api.StatusPages().Components(upapi.PrimaryKey(statusPageID)).
+			List(ctx, upapi.StatusPageComponentListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	}, func(c upapi.StatusPageComponent) bool {
+		components[c.PK] = c
+		return true
+	})
+	return components, err
+}
+
+// candidateChecks returns the checks that may match services and tags: the
+// checks in services, read by ID, and the checks with one of tags. The check
+// list can't be filtered by the API, so checks are listed page by page and
+// filtered on their tags here. Services given by check name keep every
+// listed check.
+func (a StatusPageComponentSetResourceAPI) candidateChecks(ctx context.Context, services, tags []string) ([]upapi.Check, error) {
+	var checks []upapi.Check
+	seen := make(map[int64]bool)
+	add := func(c upapi.Check) {
+		if !seen[c.PK] {
+			seen[c.PK] = true
+			checks = append(checks, c)
+		}
+	}
+
+	var byName bool
+	for _, s := range services {
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			byName = true
+			continue
+		}
+		c, err := a.provider.api.Checks().Get(ctx, upapi.PrimaryKey(id))
+		if isNotFoundError(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read check %d: %w", id, err)
+		}
+		add(*c)
+	}
+	if !byName && len(tags) == 0 {
+		return checks, nil
+	}
+	err := ListEach(ctx, listPageSize, func(ctx context.Context, page, pageSize int64) ([]upapi.Check, int64, error) {
+		api, err := a.provider.api.Checks().List(ctx, upapi.CheckListOptions{
+			Page:     page,
+			PageSize: pageSize,
+		})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	}, func(c upapi.Check) bool {
+		if byName || slices.ContainsFunc(c.Tags, func(t string) bool { return slices.Contains(tags, t) }) {
+			add(c)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return checks, nil
+}
+
+// desired returns the components the set should have, keeping the
+// components of existing for checks still selected.
+func (a StatusPageComponentSetResourceAPI) desired(
+	ctx context.Context, arg StatusPageComponentSet, existing []StatusPageComponentSetEntry,
+) ([]StatusPageComponentSetEntry, error) {
+	var group *upapi.Check
+	if arg.CheckGroupID != 0 {
+		var err error
+		if group, err = a.provider.api.Checks().Get(ctx, upapi.PrimaryKey(arg.CheckGroupID)); err != nil {
+			return nil, fmt.Errorf("failed to read check group %d: %w", arg.CheckGroupID, err)
+		}
+		if group.GroupConfig == nil {
+			return nil, fmt.Errorf("check %d is not a check group", arg.CheckGroupID)
+		}
+	}
+	services, tags := arg.selector(group)
+	checks, err := a.candidateChecks(ctx, services, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	componentIDs := make(map[int64]int64, len(existing))
+	for _, e := range existing {
+		componentIDs[e.CheckID] = e.ComponentID
+	}
+	var out []StatusPageComponentSetEntry
+	for _, c := range selectChecks(checks, services, tags) {
+		out = append(out, StatusPageComponentSetEntry{
+			CheckID:     c.PK,
+			ComponentID: componentIDs[c.PK],
+			Name:        arg.componentName(c),
+		})
+	}
+	return out, nil
+}
+
+// apply creates, updates and deletes components so that the status page has
+// the components of arg, given the components the set had before.
+func (a StatusPageComponentSetResourceAPI) apply(
+	ctx context.Context, existing []StatusPageComponentSetEntry, arg StatusPageComponentSet,
+) (*StatusPageComponentSet, error) {
+	desired := arg.Components
+	if !arg.Planned {
+		var err error
+		if desired, err = a.desired(ctx, arg, existing); err != nil {
+			return nil, err
+		}
+	}
+	components, err := a.statusPageComponents(ctx, arg.StatusPageID)
+	if err != nil {
+		return nil, err
+	}
+	api := a.provider.api.StatusPages().Components(upapi.PrimaryKey(arg.StatusPageID))
+
+	result := arg
+	result.Components = make([]StatusPageComponentSetEntry, 0, len(desired))
+	kept := make(map[int64]bool)
+	for _, e := range desired {
+		c, ok := components[e.ComponentID]
+		if !ok {
+			obj, err := api.Create(ctx, upapi.StatusPageComponent{
+				Name:           e.Name,
+				ServiceID:      &e.CheckID,
+				AutoStatusDown: arg.AutoStatusDown,
+				AutoStatusUp:   arg.AutoStatusUp,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create component for check %d: %w", e.CheckID, err)
+			}
+			e.ComponentID = obj.PK
+		} else if c.Name != e.Name || valueOrZero(c.ServiceID) != e.CheckID ||
+			(arg.AutoStatusDown != "" && c.AutoStatusDown != arg.AutoStatusDown) ||
+			(arg.AutoStatusUp != "" && c.AutoStatusUp != arg.AutoStatusUp) {
+			c.Name, c.ServiceID = e.Name, &e.CheckID
+			if arg.AutoStatusDown != "" {
+				c.AutoStatusDown = arg.AutoStatusDown
+			}
+			if arg.AutoStatusUp != "" {
+				c.AutoStatusUp = arg.AutoStatusUp
+			}
+			if _, err := api.Update(ctx, upapi.PrimaryKey(c.PK), c); err != nil {
+				return nil, fmt.Errorf("failed to update component %d: %w", c.PK, err)
+			}
+		}
+		kept[e.ComponentID] = true
+		result.Components = append(result.Components, e)
+	}
+
+	for _, e := range existing {
+		if kept[e.ComponentID] {
+			continue
+		}
+		if err := api.Delete(ctx, upapi.PrimaryKey(e.ComponentID)); err != nil && !isNotFoundError(err) {
+			return nil, fmt.Errorf("failed to delete component %d: %w", e.ComponentID, err)
+		}
+	}
+	result.Planned = true
+	return &result, nil
+}
+
+func (a StatusPageComponentSetResourceAPI) Create(ctx context.Context, arg StatusPageComponentSet) (*StatusPageComponentSet, error) {
+	return a.apply(ctx, nil, arg)
+}
+
+func (a StatusPageComponentSetResourceAPI) Read(ctx context.Context, arg upapi.PrimaryKeyable) (*StatusPageComponentSet, error) {
+	model, ok := arg.(StatusPageComponentSetResourceModel)
+	if !ok {
+		return nil, fmt.Errorf("resource read failed: unexpected type %T", arg)
+	}
+	state, err := StatusPageComponentSetResourceModelAdapter{}.ToAPIArgument(model)
+	if err != nil {
+		return nil, err
+	}
+	components, err := a.statusPageComponents(ctx, state.StatusPageID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Report what became of the components of the set; the plan brings them
+	// back in line with the selector.
+	result := *state
+	result.Components = nil
+	for _, e := range state.Components {
+		c, ok := components[e.ComponentID]
+		if !ok {
+			continue
+		}
+		result.Components = append(result.Components, StatusPageComponentSetEntry{
+			CheckID:     valueOrZero(c.ServiceID),
+			ComponentID: c.PK,
+			Name:        c.Name,
+		})
+	}
+	return &result, nil
+}
+
+func (a StatusPageComponentSetResourceAPI) Update(
+	ctx context.Context, pk upapi.PrimaryKeyable, arg StatusPageComponentSet,
+) (*StatusPageComponentSet, error) {
+	model, ok := pk.(StatusPageComponentSetResourceModel)
+	if !ok {
+		return nil, fmt.Errorf("resource update failed: unexpected type %T", pk)
+	}
+	state, err := StatusPageComponentSetResourceModelAdapter{}.ToAPIArgument(model)
+	if err != nil {
+		return nil, err
+	}
+	return a.apply(ctx, state.Components, arg)
+}
+
+func (a StatusPageComponentSetResourceAPI) Delete(ctx context.Context, arg upapi.PrimaryKeyable) error {
+	model, ok := arg.(StatusPageComponentSetResourceModel)
+	if !ok {
+		return fmt.Errorf("resource delete failed: unexpected type %T", arg)
+	}
+	api := a.provider.api.StatusPages().Components(upapi.PrimaryKey(model.StatusPageID.ValueInt64()))
+	for _, v := range model.components {
+		if err := api.Delete(ctx, upapi.PrimaryKey(v.ComponentID.ValueInt64())); err != nil && !isNotFoundError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// statusPageComponentSetPlanModifier plans the components of a set from the
+// checks currently matching its selector, so that checks added, renamed or
+// removed outside Terraform show up in the plan.
+type statusPageComponentSetPlanModifier struct {
+	api *StatusPageComponentSetResourceAPI
+}
+
+func (m statusPageComponentSetPlanModifier) Description(context.Context) string {
+	return "Components are planned from the checks matching the selector"
+}
+
+func (m statusPageComponentSetPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m statusPageComponentSetPlanModifier) PlanModifyList(ctx context.Context, rq planmodifier.ListRequest, rs *planmodifier.ListResponse) {
+	// New sets are computed at apply time, after the checks they may depend
+	// on have been created.
+	if rq.Plan.Raw.IsNull() || rq.State.Raw.IsNull() || m.api.provider.api == nil {
+		return
+	}
+	adapter := StatusPageComponentSetResourceModelAdapter{}
+	plan, diags := adapter.Get(ctx, rq.Plan)
+	if diags.HasError() {
+		return
+	}
+	if plan.Tags.IsUnknown() || plan.CheckGroupID.IsUnknown() || plan.NameTemplate.IsUnknown() {
+		return
+	}
+	arg, err := adapter.ToAPIArgument(*plan)
+	if err != nil {
+		return
+	}
+	state, diags := adapter.Get(ctx, rq.State)
+	if diags.HasError() {
+		return
+	}
+	existing, err := adapter.ToAPIArgument(*state)
+	if err != nil {
+		return
+	}
+
+	desired, err := m.api.desired(ctx, *arg, existing.Components)
+	if err != nil {
+		rs.Diagnostics.AddAttributeWarning(rq.Path, "Components were not planned", err.Error())
+		return
+	}
+	v, err := adapter.componentsValue(desired)
+	if err != nil {
+		rs.Diagnostics.AddAttributeError(rq.Path, "Components were not planned", err.Error())
+		return
+	}
+	rs.PlanValue = v
+}
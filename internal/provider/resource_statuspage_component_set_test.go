@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"testing"
+
+	petname "github.com/dustinkirkland/golang-petname"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+// allocate returns a new zero value of the type its argument points to.
+func allocate[T any](_ *T) *T {
+	return new(T)
+}
+
+func TestStatusPageComponentSetSelectChecks(t *testing.T) {
+	checks := []upapi.Check{
+		{PK: 1, Name: "web", Tags: []string{"prod"}},
+		{PK: 2, Name: "api", Tags: []string{"staging", "prod"}},
+		{PK: 3, Name: "db", Tags: []string{"staging"}},
+		{PK: 4, Name: "api", Tags: []string{"prod"}},
+		{PK: 5, Name: "group", Tags: []string{"prod"}},
+		{PK: 6, Name: "cdn", Tags: []string{"web"}},
+	}
+	checks[4].GroupConfig = allocate(checks[4].GroupConfig)
+	ids := func(checks []upapi.Check) (out []int64) {
+		for _, c := range checks {
+			out = append(out, c.PK)
+		}
+		return out
+	}
+
+	require.Equal(t, []int64{2, 4, 1}, ids(selectChecks(checks, nil, []string{"prod"})))
+	require.Equal(t, []int64{6, 3, 1}, ids(selectChecks(checks, []string{"3", "web"}, []string{"web"})))
+}
+
+func TestStatusPageComponentSetComponentName(t *testing.T) {
+	set := StatusPageComponentSet{NameTemplate: "{name} ({id})"}
+	require.Equal(t, "API (42)", set.componentName(upapi.Check{PK: 42, Name: "API"}))
+}
+
+func TestAccStatusPageComponentSetResource(t *testing.T) {
+	name := petname.Generate(3, "-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { _ = testAccAPIClient(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/resource_statuspage_component_set/_basic"),
+				ConfigVariables: config.Variables{
+					"name":   config.StringVariable(name),
+					"checks": config.IntegerVariable(2),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptime_statuspage_component_set.test", "components.#", "2"),
+					resource.TestCheckResourceAttr("uptime_statuspage_component_set.test", "components.0.name", name+"-0"),
+					resource.TestCheckResourceAttrPair("uptime_statuspage_component_set.test", "components.1.check_id", "uptime_check_http.test.1", "id"),
+				),
+			},
+			{
+				// The check added here only exists after this apply, so the
+				// follow-up plan adds its component.
+				ConfigDirectory: config.StaticDirectory("testdata/resource_statuspage_component_set/_basic"),
+				ConfigVariables: config.Variables{
+					"name":   config.StringVariable(name),
+					"checks": config.IntegerVariable(3),
+				},
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/resource_statuspage_component_set/_basic"),
+				ConfigVariables: config.Variables{
+					"name":   config.StringVariable(name),
+					"checks": config.IntegerVariable(3),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptime_statuspage_component_set.test", "components.#", "3"),
+					resource.TestCheckResourceAttrPair("uptime_statuspage_component_set.test", "components.2.check_id", "uptime_check_http.test.2", "id"),
+				),
+			},
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/resource_statuspage_component_set/_basic"),
+				ConfigVariables: config.Variables{
+					"name":          config.StringVariable(name),
+					"checks":        config.IntegerVariable(1),
+					"name_template": config.StringVariable("Service {name}"),
+				},
+				// The removed checks are deleted in this apply, after the plan
+				// that still listed them.
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/resource_statuspage_component_set/_basic"),
+				ConfigVariables: config.Variables{
+					"name":          config.StringVariable(name),
+					"checks":        config.IntegerVariable(1),
+					"name_template": config.StringVariable("Service {name}"),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptime_statuspage_component_set.test", "components.#", "1"),
+					resource.TestCheckResourceAttr("uptime_statuspage_component_set.test", "components.0.name", "Service "+name+"-0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,384 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+// statusPageLayoutWeightStep is the difference between the sorting weights of
+// consecutive components in a layout. The gaps leave room for components added
+// in the UI without renumbering the page.
+const statusPageLayoutWeightStep = 10
+
+func NewStatusPageLayoutResource(_ context.Context, p *providerImpl) resource.Resource {
+	return NewImportableAPIResource[StatusPageLayoutResourceModel, StatusPageLayout, StatusPageLayout](
+		&StatusPageLayoutResourceAPI{provider: p},
+		StatusPageLayoutResourceModelAdapter{},
+		APIResourceMetadata{
+			TypeNameSuffix: "statuspage_layout",
+			Schema: schema.Schema{
+				Description: "Order of the components of a status page and their grouping. The provider computes " +
+					"the `sorting_weight` and `group_id` of every listed component, and reports components moved " +
+					"in the UI as drift. Don't set `sorting_weight` or `group_id` on `uptime_statuspage_component` " +
+					"resources listed in a layout. Components left out of the layout keep their position. " +
+					"Destroying the layout leaves the components where they are. " +
+					"Import using the status page ID: `terraform import uptime_statuspage_layout.example statuspage_id`",
+				Attributes: map[string]schema.Attribute{
+					"statuspage_id": schema.Int64Attribute{
+						Required: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+					"id": IDSchemaAttribute(),
+					"items": schema.ListNestedAttribute{
+						Required:    true,
+						Description: "Top-level components and groups of the status page, in display order",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"component_id": schema.Int64Attribute{
+									Required:    true,
+									Description: "ID of the component or group",
+								},
+								"component_ids": schema.ListAttribute{
+									ElementType: types.Int64Type,
+									Optional:    true,
+									Description: "IDs of the components in the group, in display order. Only valid when " +
+										"`component_id` is a group; omit it for groups without components",
+									Validators: []validator.List{
+										listvalidator.SizeAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		ImportStateStatusPageID,
+	)
+}
+
+// StatusPageLayout is the tree of components of a status page.
+type StatusPageLayout struct {
+	StatusPageID int64
+	Items        []StatusPageLayoutItem
+}
+
+func (l StatusPageLayout) PrimaryKey() upapi.PrimaryKey {
+	return upapi.PrimaryKey(l.StatusPageID)
+}
+
+// StatusPageLayoutItem is a top-level component, or a group and its members.
+type StatusPageLayoutItem struct {
+	ComponentID  int64
+	ComponentIDs []int64
+}
+
+// statusPageLayoutPosition is where a layout puts a component.
+type statusPageLayoutPosition struct {
+	GroupID       *int64
+	SortingWeight int64
+}
+
+// positions numbers the components of the layout in display order and
+// assigns members to their group. It fails on components listed twice.
+func (l StatusPageLayout) positions() (map[int64]statusPageLayoutPosition, error) {
+	positions := make(map[int64]statusPageLayoutPosition)
+	place := func(id int64, groupID *int64) error {
+		if _, ok := positions[id]; ok {
+			return fmt.Errorf("component %d is listed more than once", id)
+		}
+		positions[id] = statusPageLayoutPosition{
+			GroupID:       groupID,
+			SortingWeight: int64(len(positions)+1) * statusPageLayoutWeightStep,
+		}
+		return nil
+	}
+	for _, item := range l.Items {
+		if err := place(item.ComponentID, nil); err != nil {
+			return nil, err
+		}
+		for _, id := range item.ComponentIDs {
+			if err := place(id, &item.ComponentID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return positions, nil
+}
+
+// ids returns the IDs of all components in the layout.
+func (l StatusPageLayout) ids() map[int64]bool {
+	ids := make(map[int64]bool)
+	for _, item := range l.Items {
+		ids[item.ComponentID] = true
+		for _, id := range item.ComponentIDs {
+			ids[id] = true
+		}
+	}
+	return ids
+}
+
+// statusPageLayoutFromComponents builds the layout shown on the status page,
+// ordering components by sorting weight, then ID. Unless known is empty, only
+// components in known are kept, along with the groups they are in.
+func statusPageLayoutFromComponents(
+	statusPageID int64, components []upapi.StatusPageComponent, known map[int64]bool,
+) StatusPageLayout {
+	components = slices.Clone(components)
+	slices.SortFunc(components, func(a, b upapi.StatusPageComponent) int {
+		return cmp.Or(
+			cmp.Compare(valueOrZero(a.SortingWeight), valueOrZero(b.SortingWeight)),
+			cmp.Compare(a.PK, b.PK),
+		)
+	})
+	keep := func(id int64) bool {
+		return len(known) == 0 || known[id]
+	}
+
+	groups := make(map[int64]bool)
+	for _, c := range components {
+		if c.IsGroup {
+			groups[c.PK] = true
+		}
+	}
+	members := make(map[int64][]int64)
+	for _, c := range components {
+		if c.GroupID != nil && groups[*c.GroupID] && keep(c.PK) {
+			members[*c.GroupID] = append(members[*c.GroupID], c.PK)
+		}
+	}
+
+	layout := StatusPageLayout{StatusPageID: statusPageID}
+	for _, c := range components {
+		if c.GroupID != nil && groups[*c.GroupID] {
+			continue
+		}
+		if !keep(c.PK) && len(members[c.PK]) == 0 {
+			continue
+		}
+		layout.Items = append(layout.Items, StatusPageLayoutItem{ComponentID: c.PK, ComponentIDs: members[c.PK]})
+	}
+	return layout
+}
+
+func valueOrZero[T any](v *T) T {
+	if v == nil {
+		return *new(T)
+	}
+	return *v
+}
+
+type StatusPageLayoutResourceModel struct {
+	StatusPageID types.Int64 `tfsdk:"statuspage_id"`
+	ID           types.Int64 `tfsdk:"id"`
+	Items        types.List  `tfsdk:"items"`
+
+	items []StatusPageLayoutItemAttribute
+}
+
+func (m StatusPageLayoutResourceModel) PrimaryKey() upapi.PrimaryKey {
+	return upapi.PrimaryKey(m.StatusPageID.ValueInt64())
+}
+
+type StatusPageLayoutItemAttribute struct {
+	ComponentID  types.Int64 `tfsdk:"component_id"`
+	ComponentIDs types.List  `tfsdk:"component_ids"`
+}
+
+type StatusPageLayoutResourceModelAdapter struct{}
+
+func (a StatusPageLayoutResourceModelAdapter) itemAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"component_id":  types.Int64Type,
+		"component_ids": types.ListType{ElemType: types.Int64Type},
+	}
+}
+
+func (a StatusPageLayoutResourceModelAdapter) Get(
+	ctx context.Context, sg StateGetter,
+) (*StatusPageLayoutResourceModel, diag.Diagnostics) {
+	var model StatusPageLayoutResourceModel
+	if diags := sg.Get(ctx, &model); diags.HasError() {
+		return nil, diags
+	}
+	if !model.Items.IsNull() && !model.Items.IsUnknown() {
+		if diags := model.Items.ElementsAs(ctx, &model.items, false); diags.HasError() {
+			return nil, diags
+		}
+	}
+	return &model, nil
+}
+
+func (a StatusPageLayoutResourceModelAdapter) ToAPIArgument(
+	model StatusPageLayoutResourceModel,
+) (*StatusPageLayout, error) {
+	api := StatusPageLayout{
+		StatusPageID: model.StatusPageID.ValueInt64(),
+		Items:        make([]StatusPageLayoutItem, len(model.items)),
+	}
+	for i, item := range model.items {
+		api.Items[i].ComponentID = item.ComponentID.ValueInt64()
+		for _, v := range item.ComponentIDs.Elements() {
+			id, ok := v.(types.Int64)
+			if !ok || id.IsUnknown() {
+				return nil, fmt.Errorf("component IDs of group %d are not known yet", api.Items[i].ComponentID)
+			}
+			api.Items[i].ComponentIDs = append(api.Items[i].ComponentIDs, id.ValueInt64())
+		}
+	}
+	return &api, nil
+}
+
+func (a StatusPageLayoutResourceModelAdapter) FromAPIResult(api StatusPageLayout) (*StatusPageLayoutResourceModel, error) {
+	items := make([]attr.Value, len(api.Items))
+	for i, item := range api.Items {
+		ids := types.ListNull(types.Int64Type)
+		if len(item.ComponentIDs) != 0 {
+			values := make([]attr.Value, len(item.ComponentIDs))
+			for j, id := range item.ComponentIDs {
+				values[j] = types.Int64Value(id)
+			}
+			var d diag.Diagnostics
+			if ids, d = types.ListValue(types.Int64Type, values); d.HasError() {
+				return nil, fmt.Errorf("failed to convert group %d: %v", item.ComponentID, d)
+			}
+		}
+		v, d := types.ObjectValue(a.itemAttributeTypes(), map[string]attr.Value{
+			"component_id":  types.Int64Value(item.ComponentID),
+			"component_ids": ids,
+		})
+		if d.HasError() {
+			return nil, fmt.Errorf("failed to convert component %d: %v", item.ComponentID, d)
+		}
+		items[i] = v
+	}
+	list, d := types.ListValue(types.ObjectType{AttrTypes: a.itemAttributeTypes()}, items)
+	if d.HasError() {
+		return nil, fmt.Errorf("failed to convert layout: %v", d)
+	}
+	return &StatusPageLayoutResourceModel{
+		StatusPageID: types.Int64Value(api.StatusPageID),
+		ID:           types.Int64Value(api.StatusPageID),
+		Items:        list,
+	}, nil
+}
+
+type StatusPageLayoutResourceAPI struct {
+	provider *providerImpl
+}
+
+func (a StatusPageLayoutResourceAPI) components(ctx context.Context, statusPageID int64) ([]upapi.StatusPageComponent, error) {
+	var components []upapi.StatusPageComponent
+	err := ListEach(ctx, listPageSize, func(ctx context.Context, page, pageSize int64) ([]upapi.StatusPageComponent, int64, error) {
+		api, err := a.provider.api.StatusPages().Components(upapi.PrimaryKey(statusPageID)).
+			List(ctx, upapi.StatusPageComponentListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	}, func(c upapi.StatusPageComponent) bool {
+		components = append(components, c)
+		return true
+	})
+	return components, err
+}
+
+// apply moves the components of the status page to the positions given by
+// arg, updating only those not already there.
+func (a StatusPageLayoutResourceAPI) apply(ctx context.Context, arg StatusPageLayout) (*StatusPageLayout, error) {
+	positions, err := arg.positions()
+	if err != nil {
+		return nil, err
+	}
+	components, err := a.components(ctx, arg.StatusPageID)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int64]upapi.StatusPageComponent, len(components))
+	for _, c := range components {
+		byID[c.PK] = c
+	}
+	for id := range positions {
+		if _, ok := byID[id]; !ok {
+			return nil, fmt.Errorf("component %d is not on status page %d", id, arg.StatusPageID)
+		}
+	}
+	for _, item := range arg.Items {
+		if len(item.ComponentIDs) != 0 && !byID[item.ComponentID].IsGroup {
+			return nil, fmt.Errorf("component %d is not a group and can't have components", item.ComponentID)
+		}
+		for _, id := range item.ComponentIDs {
+			if byID[id].IsGroup {
+				return nil, fmt.Errorf("group %d can't be put in group %d", id, item.ComponentID)
+			}
+		}
+	}
+
+	for i, c := range components {
+		pos, ok := positions[c.PK]
+		if !ok {
+			continue
+		}
+		if valueOrZero(c.GroupID) == valueOrZero(pos.GroupID) && valueOrZero(c.SortingWeight) == pos.SortingWeight {
+			continue
+		}
+		c.GroupID, c.SortingWeight = pos.GroupID, &pos.SortingWeight
+		obj, err := a.provider.api.StatusPages().Components(upapi.PrimaryKey(arg.StatusPageID)).
+			Update(ctx, upapi.PrimaryKey(c.PK), c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to move component %d: %w", c.PK, err)
+		}
+		components[i] = *obj
+	}
+
+	layout := statusPageLayoutFromComponents(arg.StatusPageID, components, arg.ids())
+	return &layout, nil
+}
+
+func (a StatusPageLayoutResourceAPI) Create(ctx context.Context, arg StatusPageLayout) (*StatusPageLayout, error) {
+	return a.apply(ctx, arg)
+}
+
+func (a StatusPageLayoutResourceAPI) Read(ctx context.Context, arg upapi.PrimaryKeyable) (*StatusPageLayout, error) {
+	model, ok := arg.(StatusPageLayoutResourceModel)
+	if !ok {
+		return nil, fmt.Errorf("resource read failed: unexpected type %T", arg)
+	}
+	statusPageID := model.StatusPageID.ValueInt64()
+	components, err := a.components(ctx, statusPageID)
+	if err != nil {
+		return nil, err
+	}
+	known, err := StatusPageLayoutResourceModelAdapter{}.ToAPIArgument(model)
+	if err != nil {
+		return nil, err
+	}
+	layout := statusPageLayoutFromComponents(statusPageID, components, known.ids())
+	return &layout, nil
+}
+
+func (a StatusPageLayoutResourceAPI) Update(ctx context.Context, _ upapi.PrimaryKeyable, arg StatusPageLayout) (*StatusPageLayout, error) {
+	return a.apply(ctx, arg)
+}
+
+// Delete leaves the components where the layout put them.
+func (a StatusPageLayoutResourceAPI) Delete(context.Context, upapi.PrimaryKeyable) error {
+	return nil
+}
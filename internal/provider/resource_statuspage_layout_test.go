@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	petname "github.com/dustinkirkland/golang-petname"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/require"
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func TestStatusPageLayoutPositions(t *testing.T) {
+	layout := StatusPageLayout{Items: []StatusPageLayoutItem{
+		{ComponentID: 3},
+		{ComponentID: 1, ComponentIDs: []int64{4, 2}},
+		{ComponentID: 5},
+	}}
+	positions, err := layout.positions()
+	require.NoError(t, err)
+	group := int64(1)
+	require.Equal(t, map[int64]statusPageLayoutPosition{
+		3: {SortingWeight: 10},
+		1: {SortingWeight: 20},
+		4: {GroupID: &group, SortingWeight: 30},
+		2: {GroupID: &group, SortingWeight: 40},
+		5: {SortingWeight: 50},
+	}, positions)
+
+	layout.Items = append(layout.Items, StatusPageLayoutItem{ComponentID: 2})
+	_, err = layout.positions()
+	require.ErrorContains(t, err, "component 2 is listed more than once")
+}
+
+func TestStatusPageLayoutFromComponents(t *testing.T) {
+	ptr := func(v int64) *int64 { return &v }
+	components := []upapi.StatusPageComponent{
+		{PK: 1, IsGroup: true, SortingWeight: ptr(20)},
+		{PK: 2, GroupID: ptr(1), SortingWeight: ptr(40)},
+		{PK: 3, SortingWeight: ptr(10)},
+		{PK: 4, GroupID: ptr(1), SortingWeight: ptr(30)},
+		{PK: 5, SortingWeight: ptr(10)},
+		{PK: 6, IsGroup: true, SortingWeight: ptr(60)},
+		{PK: 7},
+	}
+
+	require.Equal(t, StatusPageLayout{StatusPageID: 9, Items: []StatusPageLayoutItem{
+		{ComponentID: 7},
+		{ComponentID: 3},
+		{ComponentID: 5},
+		{ComponentID: 1, ComponentIDs: []int64{4, 2}},
+		{ComponentID: 6},
+	}}, statusPageLayoutFromComponents(9, components, nil))
+
+	// Components moved into a group left out of the layout bring the group.
+	components[2].GroupID = ptr(6)
+	require.Equal(t, StatusPageLayout{StatusPageID: 9, Items: []StatusPageLayoutItem{
+		{ComponentID: 1, ComponentIDs: []int64{2}},
+		{ComponentID: 6, ComponentIDs: []int64{3}},
+	}}, statusPageLayoutFromComponents(9, components, map[int64]bool{1: true, 2: true, 3: true}))
+}
+
+func TestAccStatusPageLayoutResource(t *testing.T) {
+	name := petname.Generate(3, "-")
+
+	var statusPageID, componentID int64
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { _ = testAccAPIClient(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/resource_statuspage_layout/_basic"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(name),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptime_statuspage_layout.test", "items.#", "2"),
+					resource.TestCheckResourceAttrPair("uptime_statuspage_layout.test", "items.0.component_id", "uptime_statuspage_component.test.0", "id"),
+					resource.TestCheckResourceAttrPair("uptime_statuspage_layout.test", "items.1.component_ids.1", "uptime_statuspage_component.test.2", "id"),
+					resource.TestCheckResourceAttrWith("uptime_statuspage.test", "id", func(v string) error {
+						id, err := strconv.ParseInt(v, 10, 64)
+						statusPageID = id
+						return err
+					}),
+					resource.TestCheckResourceAttrWith("uptime_statuspage_component.test.1", "id", func(v string) error {
+						id, err := strconv.ParseInt(v, 10, 64)
+						componentID = id
+						return err
+					}),
+				),
+			},
+			{
+				// Moving a component out of its group in the UI shows up as drift.
+				PreConfig: func() {
+					api := testAccAPIClient(t).StatusPages().Components(upapi.PrimaryKey(statusPageID))
+					obj, err := api.Get(context.Background(), upapi.PrimaryKey(componentID))
+					require.NoError(t, err)
+					obj.GroupID = nil
+					_, err = api.Update(context.Background(), upapi.PrimaryKey(componentID), *obj)
+					require.NoError(t, err, "out-of-band component move failed")
+				},
+				ConfigDirectory: config.StaticDirectory("testdata/resource_statuspage_layout/_basic"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(name),
+				},
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/resource_statuspage_layout/_basic"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(name),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptime_statuspage_layout.test", "items.#", "2"),
+					resource.TestCheckResourceAttr("uptime_statuspage_layout.test", "items.1.component_ids.#", "2"),
+				),
+			},
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/resource_statuspage_layout/reordered"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(name),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptime_statuspage_layout.test", "items.#", "3"),
+					resource.TestCheckResourceAttrPair("uptime_statuspage_layout.test", "items.0.component_id", "uptime_statuspage_component.group", "id"),
+					resource.TestCheckResourceAttr("uptime_statuspage_layout.test", "items.0.component_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("uptime_statuspage_layout.test", "items.2.component_id", "uptime_statuspage_component.test.0", "id"),
+				),
+			},
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/resource_statuspage_layout/reordered"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(name),
+				},
+				ResourceName:      "uptime_statuspage_layout.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["uptime_statuspage.test"]
+					if rs == nil {
+						return "", fmt.Errorf("resource not found in state")
+					}
+					return rs.Primary.Attributes["id"], nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func NewStatusPageUsersResource(_ context.Context, p *providerImpl) resource.Resource {
+	return NewImportableAPIResource[StatusPageUsersResourceModel, StatusPageUsers, StatusPageUsers](
+		&StatusPageUsersResourceAPI{provider: p},
+		StatusPageUsersResourceModelAdapter{},
+		APIResourceMetadata{
+			TypeNameSuffix: "statuspage_users",
+			Schema: schema.Schema{
+				Description: "All users with access to a status page, keyed by email address. Users missing from " +
+					"the map are removed, new ones are added and changed ones updated; the others are left alone. " +
+					"Don't combine with `uptime_statuspage_user` for the same status page. " +
+					"Destroying the resource removes the listed users. " +
+					"Import using the status page ID: `terraform import uptime_statuspage_users.example statuspage_id`",
+				Attributes: map[string]schema.Attribute{
+					"statuspage_id": schema.Int64Attribute{
+						Required: true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+					"id": IDSchemaAttribute(),
+					"users": schema.MapNestedAttribute{
+						Required:    true,
+						Description: "Users of the status page by lowercase email address",
+						Validators: []validator.Map{
+							mapvalidator.KeysAre(EmailValidator()),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"first_name": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  stringdefault.StaticString(""),
+								},
+								"last_name": schema.StringAttribute{
+									Optional: true,
+									Computed: true,
+									Default:  stringdefault.StaticString(""),
+								},
+								"is_active": schema.BoolAttribute{
+									Optional:    true,
+									Computed:    true,
+									Default:     booldefault.StaticBool(true),
+									Description: "Set to false to revoke access while keeping the user",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		ImportStateStatusPageID,
+	)
+}
+
+// StatusPageUsers is the full list of users of a status page.
+type StatusPageUsers struct {
+	StatusPageID int64
+	Users        []upapi.StatusPageUser
+}
+
+func (u StatusPageUsers) PrimaryKey() upapi.PrimaryKey {
+	return upapi.PrimaryKey(u.StatusPageID)
+}
+
+// statusPageUsersDiff returns the users in desired missing from existing,
+// the existing users whose names or state differ from desired, with the
+// desired values, and the IDs of existing users not in desired, including
+// duplicates.
+func statusPageUsersDiff(
+	existing, desired []upapi.StatusPageUser,
+) (create, update []upapi.StatusPageUser, remove []int64) {
+	want := make(map[string]upapi.StatusPageUser, len(desired))
+	for _, u := range desired {
+		want[u.Email] = u
+	}
+	have := make(map[string]bool, len(existing))
+	for _, u := range existing {
+		d, ok := want[u.Email]
+		if !ok || have[u.Email] {
+			remove = append(remove, u.PK)
+			continue
+		}
+		have[u.Email] = true
+		if d.FirstName != u.FirstName || d.LastName != u.LastName || d.IsActive != u.IsActive {
+			d.PK = u.PK
+			update = append(update, d)
+		}
+	}
+	for _, u := range desired {
+		if !have[u.Email] {
+			create = append(create, u)
+		}
+	}
+	return create, update, remove
+}
+
+type StatusPageUsersResourceModel struct {
+	StatusPageID types.Int64 `tfsdk:"statuspage_id"`
+	ID           types.Int64 `tfsdk:"id"`
+	Users        types.Map   `tfsdk:"users"`
+
+	users map[string]StatusPageUsersItemAttribute
+}
+
+func (m StatusPageUsersResourceModel) PrimaryKey() upapi.PrimaryKey {
+	return upapi.PrimaryKey(m.StatusPageID.ValueInt64())
+}
+
+type StatusPageUsersItemAttribute struct {
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	IsActive  types.Bool   `tfsdk:"is_active"`
+}
+
+type StatusPageUsersResourceModelAdapter struct{}
+
+func (a StatusPageUsersResourceModelAdapter) itemAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"first_name": types.StringType,
+		"last_name":  types.StringType,
+		"is_active":  types.BoolType,
+	}
+}
+
+func (a StatusPageUsersResourceModelAdapter) Get(
+	ctx context.Context, sg StateGetter,
+) (*StatusPageUsersResourceModel, diag.Diagnostics) {
+	var model StatusPageUsersResourceModel
+	if diags := sg.Get(ctx, &model); diags.HasError() {
+		return nil, diags
+	}
+	if !model.Users.IsNull() && !model.Users.IsUnknown() {
+		if diags := model.Users.ElementsAs(ctx, &model.users, false); diags.HasError() {
+			return nil, diags
+		}
+	}
+	return &model, nil
+}
+
+func (a StatusPageUsersResourceModelAdapter) ToAPIArgument(
+	model StatusPageUsersResourceModel,
+) (*StatusPageUsers, error) {
+	api := StatusPageUsers{StatusPageID: model.StatusPageID.ValueInt64()}
+	for email, u := range model.users {
+		api.Users = append(api.Users, upapi.StatusPageUser{
+			Email:     email,
+			FirstName: u.FirstName.ValueString(),
+			LastName:  u.LastName.ValueString(),
+			IsActive:  u.IsActive.ValueBool(),
+		})
+	}
+	slices.SortFunc(api.Users, compareStatusPageUsers)
+	return &api, nil
+}
+
+func (a StatusPageUsersResourceModelAdapter) FromAPIResult(api StatusPageUsers) (*StatusPageUsersResourceModel, error) {
+	values := make(map[string]attr.Value, len(api.Users))
+	for _, u := range api.Users {
+		v, diags := types.ObjectValue(a.itemAttributeTypes(), map[string]attr.Value{
+			"first_name": types.StringValue(u.FirstName),
+			"last_name":  types.StringValue(u.LastName),
+			"is_active":  types.BoolValue(u.IsActive),
+		})
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to convert user %s: %v", u.Email, diags)
+		}
+		values[u.Email] = v
+	}
+	users, diags := types.MapValue(types.ObjectType{AttrTypes: a.itemAttributeTypes()}, values)
+	if diags.HasError() {
+		return nil, fmt.Errorf("failed to convert users: %v", diags)
+	}
+	return &StatusPageUsersResourceModel{
+		StatusPageID: types.Int64Value(api.StatusPageID),
+		ID:           types.Int64Value(api.StatusPageID),
+		Users:        users,
+	}, nil
+}
+
+func compareStatusPageUsers(a, b upapi.StatusPageUser) int {
+	return cmp.Compare(a.Email, b.Email)
+}
+
+type StatusPageUsersResourceAPI struct {
+	provider *providerImpl
+}
+
+func (a StatusPageUsersResourceAPI) list(ctx context.Context, statusPageID int64) ([]upapi.StatusPageUser, error) {
+	var users []upapi.StatusPageUser
+	err := ListEach(ctx, listPageSize, func(ctx context.Context, page, pageSize int64) ([]upapi.StatusPageUser, int64, error) {
+		api, err := a.provider.api.StatusPages().Users(upapi.PrimaryKey(statusPageID)).
+			List(ctx, upapi.StatusPageUserListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return nil, 0, err
+		}
+		return api.Items, api.TotalCount, nil
+	}, func(u upapi.StatusPageUser) bool {
+		users = append(users, u)
+		return true
+	})
+	return users, err
+}
+
+// result converts the users read from the API to StatusPageUsers, keeping the
+// first of any users sharing an email address.
+func (a StatusPageUsersResourceAPI) result(statusPageID int64, users []upapi.StatusPageUser) *StatusPageUsers {
+	res := StatusPageUsers{StatusPageID: statusPageID}
+	seen := make(map[string]bool, len(users))
+	for _, u := range users {
+		if !seen[u.Email] {
+			seen[u.Email] = true
+			res.Users = append(res.Users, u)
+		}
+	}
+	slices.SortFunc(res.Users, compareStatusPageUsers)
+	return &res
+}
+
+// apply removes, updates and adds users so that the status page ends up with
+// exactly the users in arg. Removals go first to free seats on the plan.
+func (a StatusPageUsersResourceAPI) apply(ctx context.Context, arg StatusPageUsers) (*StatusPageUsers, error) {
+	existing, err := a.list(ctx, arg.StatusPageID)
+	if err != nil {
+		return nil, err
+	}
+	create, update, remove := statusPageUsersDiff(existing, arg.Users)
+	if len(create)+len(update)+len(remove) == 0 {
+		return a.result(arg.StatusPageID, existing), nil
+	}
+	endpoint := a.provider.api.StatusPages().Users(upapi.PrimaryKey(arg.StatusPageID))
+	for _, id := range remove {
+		if err := endpoint.Delete(ctx, upapi.PrimaryKey(id)); err != nil && !isNotFoundError(err) {
+			return nil, fmt.Errorf("failed to remove user %d: %w", id, err)
+		}
+	}
+	for _, u := range update {
+		if _, err := endpoint.Update(ctx, upapi.PrimaryKey(u.PK), u); err != nil {
+			return nil, fmt.Errorf("failed to update user %s: %w", u.Email, err)
+		}
+	}
+	for _, u := range create {
+		if _, err := endpoint.Create(ctx, u); err != nil {
+			return nil, fmt.Errorf("failed to add user %s: %w", u.Email, err)
+		}
+	}
+	existing, err = a.list(ctx, arg.StatusPageID)
+	if err != nil {
+		return nil, err
+	}
+	return a.result(arg.StatusPageID, existing), nil
+}
+
+func (a StatusPageUsersResourceAPI) Create(ctx context.Context, arg StatusPageUsers) (*StatusPageUsers, error) {
+	return a.apply(ctx, arg)
+}
+
+func (a StatusPageUsersResourceAPI) Read(ctx context.Context, arg upapi.PrimaryKeyable) (*StatusPageUsers, error) {
+	model, ok := arg.(StatusPageUsersResourceModel)
+	if !ok {
+		return nil, fmt.Errorf("resource read failed: unexpected type %T", arg)
+	}
+	statusPageID := model.StatusPageID.ValueInt64()
+	existing, err := a.list(ctx, statusPageID)
+	if err != nil {
+		return nil, err
+	}
+	return a.result(statusPageID, existing), nil
+}
+
+func (a StatusPageUsersResourceAPI) Update(ctx context.Context, _ upapi.PrimaryKeyable, arg StatusPageUsers) (*StatusPageUsers, error) {
+	return a.apply(ctx, arg)
+}
+
+// Delete removes the users recorded in state, leaving any added since in the
+// UI.
+func (a StatusPageUsersResourceAPI) Delete(ctx context.Context, arg upapi.PrimaryKeyable) error {
+	model, ok := arg.(StatusPageUsersResourceModel)
+	if !ok {
+		return fmt.Errorf("resource delete failed: unexpected type %T", arg)
+	}
+	statusPageID := model.StatusPageID.ValueInt64()
+	existing, err := a.list(ctx, statusPageID)
+	if err != nil {
+		return err
+	}
+	endpoint := a.provider.api.StatusPages().Users(upapi.PrimaryKey(statusPageID))
+	for _, u := range existing {
+		if _, ok := model.users[u.Email]; !ok {
+			continue
+		}
+		if err := endpoint.Delete(ctx, upapi.PrimaryKey(u.PK)); err != nil && !isNotFoundError(err) {
+			return fmt.Errorf("failed to remove user %s: %w", u.Email, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"testing"
+
+	petname "github.com/dustinkirkland/golang-petname"
+	"github.com/hashicorp/terraform-plugin-testing/config"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/require"
+	"github.com/uptime-com/uptime-client-go/v2/pkg/upapi"
+)
+
+func TestStatusPageUsersDiff(t *testing.T) {
+	existing := []upapi.StatusPageUser{
+		{PK: 1, Email: "alice@example.com", FirstName: "Alice", IsActive: true},
+		{PK: 2, Email: "bob@example.com", FirstName: "Bob", IsActive: true},
+		{PK: 3, Email: "carol@example.com", FirstName: "Carol", IsActive: true},
+		{PK: 4, Email: "alice@example.com", FirstName: "Alice", IsActive: true},
+	}
+	create, update, remove := statusPageUsersDiff(existing, []upapi.StatusPageUser{
+		{Email: "alice@example.com", FirstName: "Alice", IsActive: true},
+		{Email: "carol@example.com", FirstName: "Carol", IsActive: false},
+		{Email: "dave@example.com", FirstName: "Dave", IsActive: true},
+	})
+	require.Equal(t, []upapi.StatusPageUser{{Email: "dave@example.com", FirstName: "Dave", IsActive: true}}, create)
+	require.Equal(t, []upapi.StatusPageUser{{PK: 3, Email: "carol@example.com", FirstName: "Carol", IsActive: false}}, update)
+	require.Equal(t, []int64{2, 4}, remove)
+}
+
+func TestAccStatusPageUsersResource(t *testing.T) {
+	name := petname.Generate(3, "-")
+	user := func(first string, active bool) config.Variable {
+		return config.ObjectVariable(map[string]config.Variable{
+			"first_name": config.StringVariable(first),
+			"is_active":  config.BoolVariable(active),
+		})
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { _ = testAccAPIClient(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/resource_statuspage_users/_basic"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(name),
+					"users": config.MapVariable(map[string]config.Variable{
+						"alice@example.com": user("Alice", true),
+						"bob@example.com":   user("Bob", true),
+					}),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptime_statuspage_users.test", "users.%", "2"),
+					resource.TestCheckResourceAttr("uptime_statuspage_users.test", "users.alice@example.com.first_name", "Alice"),
+					resource.TestCheckResourceAttr("uptime_statuspage_users.test", "users.alice@example.com.last_name", ""),
+				),
+			},
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/resource_statuspage_users/_basic"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(name),
+					"users": config.MapVariable(map[string]config.Variable{
+						"alice@example.com": user("Alice", false),
+						"carol@example.com": user("Carol", true),
+					}),
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("uptime_statuspage_users.test", "users.%", "2"),
+					resource.TestCheckResourceAttr("uptime_statuspage_users.test", "users.alice@example.com.is_active", "false"),
+					resource.TestCheckResourceAttr("uptime_statuspage_users.test", "users.carol@example.com.first_name", "Carol"),
+					resource.TestCheckNoResourceAttr("uptime_statuspage_users.test", "users.bob@example.com.first_name"),
+				),
+			},
+			{
+				ConfigDirectory: config.StaticDirectory("testdata/resource_statuspage_users/_basic"),
+				ConfigVariables: config.Variables{
+					"name": config.StringVariable(name),
+					"users": config.MapVariable(map[string]config.Variable{
+						"alice@example.com": user("Alice", false),
+						"carol@example.com": user("Carol", true),
+					}),
+				},
+				ResourceName:      "uptime_statuspage_users.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
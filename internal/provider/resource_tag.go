@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"net/http"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -11,7 +13,7 @@ import (
 )
 
 func NewTagResource(_ context.Context, p *providerImpl) resource.Resource {
-	return NewImportableAPIResource[TagResourceModel, upapi.Tag, upapi.Tag](
+	return NewIdentityAPIResource[TagResourceModel, upapi.Tag, upapi.Tag](
 		TagResourceAPI{provider: p},
 		TagResourceModelAdapter{},
 		APIResourceMetadata{
@@ -92,3 +94,15 @@ func (c TagResourceAPI) Update(ctx context.Context, pk upapi.PrimaryKeyable, arg
 func (c TagResourceAPI) Delete(ctx context.Context, pk upapi.PrimaryKeyable) error {
 	return c.provider.api.Tags().Delete(ctx, pk)
 }
+
+// EnsureTag creates the named tag unless it already exists. The tags endpoint
+// can't be listed, so a creation the API rejects as invalid is taken to mean
+// the tag is already there; assigning it afterwards reports any real problem.
+func (p *providerImpl) EnsureTag(ctx context.Context, name, colorHex string) error {
+	_, err := p.api.Tags().Create(ctx, upapi.Tag{Tag: name, ColorHex: colorHex})
+	var apiErr *upapi.Error
+	if errors.As(err, &apiErr) && apiErr.Response != nil && apiErr.Response.StatusCode == http.StatusBadRequest {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,28 @@
+variable name {
+  type = string
+}
+
+resource uptime_contact test {
+  name       = var.name
+  email_list = ["noreply@example.com"]
+}
+
+resource uptime_statuspage test {
+  name = var.name
+}
+
+data uptime_contact by_name {
+  name = uptime_contact.test.name
+}
+
+data uptime_contact by_id {
+  id = uptime_contact.test.id
+}
+
+data uptime_statuspage by_name {
+  name = uptime_statuspage.test.name
+}
+
+data uptime_statuspage by_slug {
+  slug = data.uptime_statuspage.by_name.slug
+}
@@ -0,0 +1,15 @@
+resource uptime_check test {
+  type = "DNS"
+  dns = {
+    name    = var.name
+    address = var.address
+  }
+}
+
+variable name {
+  type = string
+}
+
+variable address {
+  type = string
+}
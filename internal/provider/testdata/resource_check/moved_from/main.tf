@@ -0,0 +1,9 @@
+resource uptime_check_dns test {
+  name     = var.name
+  address  = "example.com"
+  interval = 10
+}
+
+variable name {
+  type = string
+}
@@ -0,0 +1,17 @@
+resource uptime_check test {
+  type = "DNS"
+  dns = {
+    name     = var.name
+    address  = "example.com"
+    interval = 10
+  }
+}
+
+moved {
+  from = uptime_check_dns.test
+  to   = uptime_check.test
+}
+
+variable name {
+  type = string
+}
@@ -0,0 +1,37 @@
+variable "name" {
+  type = string
+}
+
+variable "checks" {
+  type = number
+}
+
+variable "name_template" {
+  type    = string
+  default = "{name}"
+}
+
+resource "uptime_tag" "test" {
+  tag       = var.name
+  color_hex = "#000000"
+}
+
+resource "uptime_check_http" "test" {
+  count   = var.checks
+  name    = "${var.name}-${count.index}"
+  address = "https://example.com"
+  tags    = [uptime_tag.test.tag]
+}
+
+resource "uptime_statuspage" "test" {
+  name = var.name
+}
+
+resource "uptime_statuspage_component_set" "test" {
+  statuspage_id    = uptime_statuspage.test.id
+  tags             = [uptime_tag.test.tag]
+  name_template    = var.name_template
+  auto_status_down = "major-outage"
+
+  depends_on = [uptime_check_http.test]
+}
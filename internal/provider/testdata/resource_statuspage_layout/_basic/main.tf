@@ -0,0 +1,33 @@
+variable "name" {
+  type = string
+}
+
+resource "uptime_statuspage" "test" {
+  name = var.name
+}
+
+resource "uptime_statuspage_component" "group" {
+  statuspage_id = uptime_statuspage.test.id
+  name          = "${var.name}-group"
+  is_group      = true
+}
+
+resource "uptime_statuspage_component" "test" {
+  count         = 3
+  statuspage_id = uptime_statuspage.test.id
+  name          = "${var.name}-${count.index}"
+}
+
+resource "uptime_statuspage_layout" "test" {
+  statuspage_id = uptime_statuspage.test.id
+  items = [
+    { component_id = uptime_statuspage_component.test[0].id },
+    {
+      component_id = uptime_statuspage_component.group.id
+      component_ids = [
+        uptime_statuspage_component.test[1].id,
+        uptime_statuspage_component.test[2].id,
+      ]
+    },
+  ]
+}
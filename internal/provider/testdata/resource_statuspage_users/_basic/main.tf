@@ -0,0 +1,21 @@
+variable "name" {
+  type = string
+}
+
+variable "users" {
+  type = map(object({
+    first_name = optional(string)
+    last_name  = optional(string)
+    is_active  = optional(bool)
+  }))
+}
+
+resource "uptime_statuspage" "test" {
+  name             = var.name
+  visibility_level = "EXTERNAL_USERS"
+}
+
+resource "uptime_statuspage_users" "test" {
+  statuspage_id = uptime_statuspage.test.id
+  users         = var.users
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/uptime-com/terraform-provider-uptime"
+
+// SetupTracing exports OpenTelemetry traces of resource operations when an
+// OTLP endpoint is configured with the standard OTEL_EXPORTER_OTLP_* environment
+// variables, and does nothing otherwise. The returned function flushes pending
+// spans and must be called before the process exits.
+func SetupTracing(ctx context.Context, version string) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+	if !tracingConfigured(os.Getenv) {
+		return noop, nil
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch protocol := tracingProtocol(os.Getenv); protocol {
+	case "grpc":
+		exporter, err = otlptracegrpc.New(ctx)
+	case "http/protobuf":
+		exporter, err = otlptracehttp.New(ctx)
+	default:
+		return noop, fmt.Errorf("unsupported OTLP protocol %q, use \"grpc\" or \"http/protobuf\"", protocol)
+	}
+	if err != nil {
+		return noop, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", "terraform-provider-uptime"),
+			attribute.String("service.version", version),
+		),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return noop, err
+	}
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
+
+// tracingConfigured tells whether the environment asks for traces to be
+// exported.
+func tracingConfigured(getenv func(string) string) bool {
+	if strings.EqualFold(getenv("OTEL_SDK_DISABLED"), "true") || getenv("OTEL_TRACES_EXPORTER") == "none" {
+		return false
+	}
+	return getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" || getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") != ""
+}
+
+func tracingProtocol(getenv func(string) string) string {
+	for _, k := range []string{"OTEL_EXPORTER_OTLP_TRACES_PROTOCOL", "OTEL_EXPORTER_OTLP_PROTOCOL"} {
+		if v := getenv(k); v != "" {
+			return v
+		}
+	}
+	return "http/protobuf"
+}
+
+// operation is the span of one resource operation.
+type operation struct {
+	span trace.Span
+}
+
+// startOperation starts the span of operation op ("create", "read", ...) on a
+// resource of type typeNameSuffix.
+func startOperation(ctx context.Context, typeNameSuffix, op string) (context.Context, *operation) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, fmt.Sprintf("uptime_%s %s", typeNameSuffix, op),
+		trace.WithAttributes(
+			attribute.String("uptime.resource.type", "uptime_"+typeNameSuffix),
+			attribute.String("uptime.operation", op),
+		),
+	)
+	return ctx, &operation{span: span}
+}
+
+// SetID records the ID of the object the operation works on.
+func (o *operation) SetID(id int64) {
+	if id != 0 {
+		o.span.SetAttributes(attribute.Int64("uptime.object.id", id))
+	}
+}
+
+// End ends the span, marking it failed when diags has errors.
+func (o *operation) End(diags diag.Diagnostics) {
+	for _, d := range diags.Errors() {
+		o.span.SetStatus(codes.Error, d.Summary())
+		o.span.RecordError(errors.New(d.Detail()), trace.WithAttributes(attribute.String("summary", d.Summary())))
+	}
+	o.span.End()
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracingConfigured(t *testing.T) {
+	cases := []struct {
+		name string
+		env  map[string]string
+		want bool
+	}{
+		{"default", nil, false},
+		{"endpoint", map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318"}, true},
+		{"traces endpoint", map[string]string{"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT": "http://localhost:4318/v1/traces"}, true},
+		{"sdk disabled", map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318", "OTEL_SDK_DISABLED": "TRUE"}, false},
+		{"exporter none", map[string]string{"OTEL_EXPORTER_OTLP_ENDPOINT": "http://localhost:4318", "OTEL_TRACES_EXPORTER": "none"}, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			require.Equal(t, c.want, tracingConfigured(func(k string) string { return c.env[k] }))
+		})
+	}
+}
+
+func TestTracingProtocol(t *testing.T) {
+	env := map[string]string{}
+	getenv := func(k string) string { return env[k] }
+	require.Equal(t, "http/protobuf", tracingProtocol(getenv))
+	env["OTEL_EXPORTER_OTLP_PROTOCOL"] = "grpc"
+	require.Equal(t, "grpc", tracingProtocol(getenv))
+	env["OTEL_EXPORTER_OTLP_TRACES_PROTOCOL"] = "http/protobuf"
+	require.Equal(t, "http/protobuf", tracingProtocol(getenv))
+}
+
+func TestTracingOperation(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(prev) })
+
+	_, op := startOperation(context.Background(), "check_http", "update")
+	op.SetID(42)
+	var diags diag.Diagnostics
+	diags.AddError("API Update Operation Failed", "boom")
+	op.End(diags)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	require.Equal(t, "uptime_check_http update", span.Name)
+	require.Equal(t, codes.Error, span.Status.Code)
+	require.Equal(t, "API Update Operation Failed", span.Status.Description)
+	require.Len(t, span.Events, 1)
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes {
+		attrs[kv.Key] = kv.Value
+	}
+	require.Equal(t, "uptime_check_http", attrs["uptime.resource.type"].AsString())
+	require.Equal(t, "update", attrs["uptime.operation"].AsString())
+	require.Equal(t, int64(42), attrs["uptime.object.id"].AsInt64())
+}
@@ -6,7 +6,9 @@ import (
 	"net/url"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -118,6 +120,33 @@ func (hostnameValidator) ValidateString(_ context.Context, rq validator.StringRe
 	}
 }
 
+// EmailValidator checks for a lowercase email address. The API stores email
+// addresses in lowercase, so other spellings would show up as drift.
+func EmailValidator() validator.String {
+	return emailValidator{}
+}
+
+type emailValidator struct {
+	zoyaDescriber
+}
+
+var emailRE = regexp.MustCompile(`^[^@\s]+@([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+func (emailValidator) ValidateString(_ context.Context, rq validator.StringRequest, rs *validator.StringResponse) {
+	if rq.ConfigValue.IsNull() || rq.ConfigValue.IsUnknown() {
+		return
+	}
+	s := rq.ConfigValue.ValueString()
+	switch {
+	case strings.ToLower(s) != s:
+		rs.Diagnostics.AddAttributeError(rq.Path, "Invalid email address",
+			fmt.Sprintf("%q must be lowercase, use %q", s, strings.ToLower(s)))
+	case !emailRE.MatchString(s):
+		rs.Diagnostics.AddAttributeError(rq.Path, "Invalid email address",
+			fmt.Sprintf("%q is not an email address such as user@example.com", s))
+	}
+}
+
 func URLValidator() validator.String {
 	return urlValidator{}
 }
@@ -242,3 +271,26 @@ func (p portMatchConfigValidator) Validate(ctx context.Context, config tfsdk.Con
 
 	return diags
 }
+
+// TimeValidator accepts an RFC 3339 timestamp, a date, or a duration relative
+// to now as understood by parseTimeArgument.
+func TimeValidator() validator.String {
+	return timeValidator{}
+}
+
+type timeValidator struct {
+	zoyaDescriber
+}
+
+func (timeValidator) ValidateString(_ context.Context, rq validator.StringRequest, rs *validator.StringResponse) {
+	if rq.ConfigValue.IsNull() || rq.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := parseTimeArgument(rq.ConfigValue.ValueString(), time.Now()); err != nil {
+		rs.Diagnostics.AddAttributeError(
+			rq.Path,
+			"Invalid value",
+			err.Error(),
+		)
+	}
+}
@@ -41,3 +41,28 @@ func TestLegacyOnlyBrandingWarningValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestEmailValidator(t *testing.T) {
+	cases := map[string]bool{
+		"alice@example.com":         true,
+		"alice.smith+ops@sub.ex.io": true,
+		"Alice@example.com":         false,
+		"alice@Example.com":         false,
+		"alice":                     false,
+		"alice@example":             false,
+		"alice@@example.com":        false,
+		"al ice@example.com":        false,
+		"@example.com":              false,
+	}
+	v := EmailValidator()
+	for value, valid := range cases {
+		t.Run(value, func(t *testing.T) {
+			rq := validator.StringRequest{Path: path.Root("users"), ConfigValue: types.StringValue(value)}
+			rs := &validator.StringResponse{}
+			v.ValidateString(context.Background(), rq, rs)
+			if got := !rs.Diagnostics.HasError(); got != valid {
+				t.Fatalf("valid = %v, want %v: %v", got, valid, rs.Diagnostics)
+			}
+		})
+	}
+}
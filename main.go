@@ -6,6 +6,7 @@ import (
 	"context"
 	"flag"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
@@ -30,7 +31,19 @@ func main() {
 
 	log.Printf("terraform-provider-uptime %s, commit %s, built at %s", version, commit, date)
 
-	err := providerserver.Serve(context.Background(), provider.VersionFactory(version), opts)
+	ctx := context.Background()
+	shutdown, err := provider.SetupTracing(ctx, version)
+	if err != nil {
+		log.Printf("tracing disabled: %s", err)
+	}
+
+	err = providerserver.Serve(ctx, provider.VersionFactory(version), opts)
+
+	flushCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	if err := shutdown(flushCtx); err != nil {
+		log.Printf("failed to flush traces: %s", err)
+	}
 	if err != nil {
 		log.Fatal(err.Error())
 	}